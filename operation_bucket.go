@@ -0,0 +1,195 @@
+package gubrak
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Bucket is a numeric range with its members, returned by `Bucketize()`. Each bucket covers `[Lower, Upper)`.
+// The first and the last bucket are open-ended, their bound is `math.Inf(-1)` and `math.Inf(1)`.
+type Bucket struct {
+	Lower float64
+	Upper float64
+	Items interface{}
+}
+
+// TimeBucket is a time range with its members, returned by `BinByTime()`. Each bucket covers `[Start, End)`.
+type TimeBucket struct {
+	Start time.Time
+	End   time.Time
+	Items interface{}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func _bucketize(err *error, data interface{}, edges []float64, keyFn interface{}) []Bucket {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	if !_validateEdges(err, edges, 1) {
+		return nil
+	}
+
+	keyOf := elementIteratee(err, "element", dataValue.Type().Elem(), []interface{}{keyFn}, true)
+	if *err != nil {
+		return nil
+	}
+
+	sliceType := reflect.SliceOf(dataValue.Type().Elem())
+	buckets := make([]Bucket, len(edges)+1)
+	items := make([]reflect.Value, len(buckets))
+	for i := range buckets {
+		buckets[i] = Bucket{Lower: math.Inf(-1), Upper: math.Inf(1)}
+		if i > 0 {
+			buckets[i].Lower = edges[i-1]
+		}
+		if i < len(edges) {
+			buckets[i].Upper = edges[i]
+		}
+
+		items[i] = makeSlice(sliceType)
+	}
+
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		key := numberToFloat(indirectValue(keyOf(each)))
+		if math.IsNaN(key) {
+			*err = errors.New("key should not be NaN")
+			return false
+		}
+
+		index := sort.SearchFloat64s(edges, key)
+		if index < len(edges) && edges[index] == key {
+			index++
+		}
+
+		items[index] = reflect.Append(items[index], each)
+		return true
+	})
+
+	for i := range buckets {
+		buckets[i].Items = items[i].Interface()
+	}
+
+	return buckets
+}
+
+// _binByTime places each element of `data` into time bucket, it also returns the inspected `data`
+func _binByTime(err *error, data interface{}, interval time.Duration, keyFn interface{}) ([]TimeBucket, reflect.Value) {
+	if !isNonNilData(err, "data", data) {
+		return nil, reflect.Value{}
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil, dataValue
+	}
+
+	if interval <= 0 {
+		*err = errors.New("interval should be greater than zero")
+		return nil, dataValue
+	}
+
+	keys := _timeKeys(err, dataValue, dataValueLen, keyFn)
+	if *err != nil {
+		return nil, dataValue
+	}
+
+	sliceType := reflect.SliceOf(dataValue.Type().Elem())
+	buckets := make([]TimeBucket, 0)
+	items := make([]reflect.Value, 0)
+
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		start, end := truncateTime(keys[i], interval)
+
+		index := sort.Search(len(buckets), func(j int) bool {
+			return !buckets[j].Start.Before(start)
+		})
+		if index == len(buckets) || !buckets[index].Start.Equal(start) {
+			buckets = append(buckets, TimeBucket{})
+			copy(buckets[index+1:], buckets[index:])
+			buckets[index] = TimeBucket{Start: start, End: end}
+
+			items = append(items, reflect.Value{})
+			copy(items[index+1:], items[index:])
+			items[index] = makeSlice(sliceType)
+		}
+
+		items[index] = reflect.Append(items[index], each)
+	})
+
+	for i := range buckets {
+		buckets[i].Items = items[i].Interface()
+	}
+
+	return buckets, dataValue
+}
+
+// _timeKeys gets the time of each element using `keyFn`, or the element itself if `keyFn` is nil
+func _timeKeys(err *error, dataValue reflect.Value, dataValueLen int, keyFn interface{}) []time.Time {
+	keyOf := elementIteratee(err, "element", dataValue.Type().Elem(), []interface{}{keyFn}, false)
+	if *err != nil {
+		return nil
+	}
+
+	keys := make([]time.Time, 0, dataValueLen)
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		key := indirectValue(keyOf(each))
+		if !key.IsValid() || key.Type() != timeType {
+			*err = fmt.Errorf("key should be time.Time, got %v", key)
+			return false
+		}
+
+		keys = append(keys, key.Interface().(time.Time))
+		return true
+	})
+
+	return keys
+}
+
+// truncateTime gets the bounds of the interval containing `t`. Truncation is based on the wall clock in the location of `t`,
+// so intervals which divide a day evenly are aligned to the local midnight, even across daylight saving time changes.
+func truncateTime(t time.Time, interval time.Duration) (time.Time, time.Time) {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	wallStart := wall.Truncate(interval)
+	wallEnd := wallStart.Add(interval)
+
+	fromWall := func(w time.Time) time.Time {
+		return time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), t.Location())
+	}
+
+	return fromWall(wallStart), fromWall(wallEnd)
+}
+
+// _validateEdges checks that `edges` has at least `minLen` elements, in strictly increasing order
+func _validateEdges(err *error, edges []float64, minLen int) bool {
+	if len(edges) < minLen {
+		if minLen == 1 {
+			*err = errors.New("edges cannot be empty")
+		} else {
+			*err = fmt.Errorf("edges should have at least %d elements", minLen)
+		}
+
+		return false
+	}
+
+	for i, edge := range edges {
+		if math.IsNaN(edge) || (i > 0 && !(edges[i-1] < edge)) {
+			*err = errors.New("edges should be in strictly increasing order")
+			return false
+		}
+	}
+
+	return true
+}
@@ -3,7 +3,12 @@ package gubrak
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func inspectFunc(err *error, data interface{}) (reflect.Value, reflect.Type) {
@@ -315,6 +320,11 @@ func isTypeEqual(err *error, labelLeft string, typeLeft reflect.Type, labelRight
 
 func catch(err *error) {
 	if r := recover(); r != nil {
+		if recovered, ok := r.(error); ok {
+			*err = recovered
+			return
+		}
+
 		*err = fmt.Errorf("%v", r)
 	}
 }
@@ -324,3 +334,601 @@ func catchWithCustomErrorMessage(err *error, callback func(string) string) {
 		*err = errors.New(callback(fmt.Sprintf("%v", r)))
 	}
 }
+
+func indirectValue(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+
+		value = value.Elem()
+	}
+
+	return value
+}
+
+func indirectType(valueType reflect.Type) reflect.Type {
+	for valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	return valueType
+}
+
+func findStructField(structType reflect.Type, name string) (reflect.StructField, bool) {
+	if field, ok := structType.FieldByName(name); ok && field.PkgPath == "" {
+		return field, true
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		tagName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tagName != "" && tagName == name {
+			return field, true
+		}
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if strings.EqualFold(field.Name, name) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func fieldTypeByPath(valueType reflect.Type, path string) (reflect.Type, error) {
+	if path == "" {
+		return nil, errors.New("field path cannot be empty")
+	}
+
+	currentType := valueType
+
+	for _, segment := range strings.Split(path, ".") {
+		currentType = indirectType(currentType)
+
+		switch currentType.Kind() {
+		case reflect.Struct:
+			field, ok := findStructField(currentType, segment)
+			if !ok {
+				return nil, fmt.Errorf("field %q not found in %s", segment, currentType.String())
+			}
+
+			currentType = field.Type
+
+		case reflect.Map:
+			if currentType.Key().Kind() != reflect.String {
+				return nil, fmt.Errorf("map key of %s must be string to resolve field %q", currentType.String(), segment)
+			}
+
+			currentType = currentType.Elem()
+
+		case reflect.Interface:
+			return currentType, nil
+
+		default:
+			return nil, fmt.Errorf("cannot resolve field %q on %s", segment, currentType.String())
+		}
+	}
+
+	return currentType, nil
+}
+
+func fieldValueByPath(value reflect.Value, path string) (reflect.Value, error) {
+	if path == "" {
+		return reflect.Value{}, errors.New("field path cannot be empty")
+	}
+
+	current := value
+
+	for _, segment := range strings.Split(path, ".") {
+		current = indirectValue(current)
+		if !current.IsValid() {
+			return reflect.Value{}, nil
+		}
+
+		switch current.Kind() {
+		case reflect.Struct:
+			field, ok := findStructField(current.Type(), segment)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("field %q not found in %s", segment, current.Type().String())
+			}
+
+			current = current.FieldByIndex(field.Index)
+
+		case reflect.Map:
+			if current.Type().Key().Kind() != reflect.String {
+				return reflect.Value{}, fmt.Errorf("map key of %s must be string to resolve field %q", current.Type().String(), segment)
+			}
+
+			current = current.MapIndex(reflect.ValueOf(segment).Convert(current.Type().Key()))
+			if !current.IsValid() {
+				return reflect.Value{}, nil
+			}
+
+		default:
+			return reflect.Value{}, fmt.Errorf("cannot resolve field %q on %s", segment, current.Type().String())
+		}
+	}
+
+	return current, nil
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+func numberToFloat(value reflect.Value) float64 {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	}
+
+	return 0
+}
+
+func compareValues(left, right reflect.Value) (int, bool) {
+	left = indirectValue(left)
+	right = indirectValue(right)
+
+	if !left.IsValid() || !right.IsValid() {
+		switch {
+		case !left.IsValid() && !right.IsValid():
+			return 0, true
+		case !left.IsValid():
+			return -1, true
+		default:
+			return 1, true
+		}
+	}
+
+	if leftTime, ok := left.Interface().(time.Time); ok {
+		rightTime, ok := right.Interface().(time.Time)
+		if !ok {
+			return 0, false
+		}
+
+		switch {
+		case leftTime.Before(rightTime):
+			return -1, true
+		case leftTime.After(rightTime):
+			return 1, true
+		}
+
+		return 0, true
+	}
+
+	leftKind, rightKind := left.Kind(), right.Kind()
+
+	switch {
+	case isNumberKind(leftKind) && isNumberKind(rightKind):
+		leftIsInt := leftKind >= reflect.Int && leftKind <= reflect.Int64
+		rightIsInt := rightKind >= reflect.Int && rightKind <= reflect.Int64
+		if leftIsInt && rightIsInt {
+			switch {
+			case left.Int() < right.Int():
+				return -1, true
+			case left.Int() > right.Int():
+				return 1, true
+			}
+
+			return 0, true
+		}
+
+		leftFloat, rightFloat := numberToFloat(left), numberToFloat(right)
+		switch {
+		case leftFloat < rightFloat:
+			return -1, true
+		case leftFloat > rightFloat:
+			return 1, true
+		}
+
+		return 0, true
+
+	case leftKind == reflect.String && rightKind == reflect.String:
+		return strings.Compare(left.String(), right.String()), true
+
+	case leftKind == reflect.Bool && rightKind == reflect.Bool:
+		switch {
+		case left.Bool() == right.Bool():
+			return 0, true
+		case !left.Bool():
+			return -1, true
+		}
+
+		return 1, true
+	}
+
+	return 0, false
+}
+
+func isValueEqual(left, right reflect.Value) bool {
+	if res, ok := compareValues(left, right); ok {
+		return res == 0
+	}
+
+	left = indirectValue(left)
+	right = indirectValue(right)
+
+	if !left.IsValid() || !right.IsValid() {
+		return left.IsValid() == right.IsValid()
+	}
+
+	return reflect.DeepEqual(left.Interface(), right.Interface())
+}
+
+func convertValueToType(value interface{}, targetType reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(targetType), nil
+	}
+
+	valueOfData := reflect.ValueOf(value)
+
+	if valueOfData.Type().AssignableTo(targetType) {
+		if targetType.Kind() == reflect.Interface {
+			result := reflect.New(targetType).Elem()
+			result.Set(valueOfData)
+			return result, nil
+		}
+
+		return valueOfData, nil
+	}
+
+	if isNumberKind(valueOfData.Kind()) && isNumberKind(targetType.Kind()) {
+		return valueOfData.Convert(targetType), nil
+	}
+
+	if valueOfData.Kind() == targetType.Kind() && valueOfData.Type().ConvertibleTo(targetType) {
+		return valueOfData.Convert(targetType), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot use %v (%s) as %s", value, valueOfData.Type().String(), targetType.String())
+}
+
+// elementIteratee returns function to get value of each element, using the optional iteratee in `args`. If iteratee is not provided, the element itself is the value.
+func elementIteratee(err *error, label string, elemType reflect.Type, args []interface{}, isNumber bool) func(reflect.Value) reflect.Value {
+	if len(args) == 0 || args[0] == nil {
+		if isNumber && !isNumberKind(elemType.Kind()) {
+			*err = fmt.Errorf("%s data type should be number, or iteratee should be provided", label)
+			return nil
+		}
+
+		return func(each reflect.Value) reflect.Value {
+			return each
+		}
+	}
+
+	callbackValue, callbackType := inspectFunc(err, args[0])
+	if *err != nil {
+		return nil
+	}
+
+	if callbackType.NumIn() != 1 || callbackType.In(0).Kind() != elemType.Kind() {
+		*err = fmt.Errorf("iteratee must only have one parameter with the same data type with %s data type", label)
+		return nil
+	}
+
+	validateFuncOutputOneVarDynamic(err, callbackType)
+	if *err != nil {
+		return nil
+	}
+
+	if isNumber && !isNumberKind(callbackType.Out(0).Kind()) {
+		*err = errors.New("iteratee return value data type should be number")
+		return nil
+	}
+
+	return func(each reflect.Value) reflect.Value {
+		return callbackValue.Call([]reflect.Value{each})[0]
+	}
+}
+
+// inspectFuncWithInputs validates that `callback` is function which receives `inputTypes` and returns one value
+func inspectFuncWithInputs(err *error, label string, callback interface{}, inputTypes ...reflect.Type) reflect.Value {
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return reflect.Value{}
+	}
+
+	isValid := callbackType.NumIn() == len(inputTypes)
+	for i := 0; isValid && i < len(inputTypes); i++ {
+		isValid = inputTypes[i].AssignableTo(callbackType.In(i))
+	}
+
+	if !isValid {
+		names := make([]string, len(inputTypes))
+		for i, inputType := range inputTypes {
+			names[i] = inputType.String()
+		}
+
+		*err = fmt.Errorf("%s parameter should be (%s)", label, strings.Join(names, ", "))
+		return reflect.Value{}
+	}
+
+	validateFuncOutputOneVarDynamic(err, callbackType)
+	return callbackValue
+}
+
+// joinIndex is hash index of join keys, keys which hold non-comparable value are matched using deep equality
+type joinIndex struct {
+	hashed   map[interface{}][]int
+	unhashed []joinIndexEntry
+}
+
+type joinIndexEntry struct {
+	key     reflect.Value
+	indexes []int
+}
+
+func newJoinIndex() *joinIndex {
+	return &joinIndex{hashed: make(map[interface{}][]int)}
+}
+
+// unhashableType returns the type of the first value inside `value` which cannot be used as map key, or nil if there is none.
+// The dynamic value is checked, since comparable type, e.g. struct with interface field, can still hold non-comparable value like slice
+func unhashableType(value reflect.Value) reflect.Type {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+
+		return unhashableType(value.Elem())
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if each := unhashableType(value.Index(i)); each != nil {
+				return each
+			}
+		}
+
+		return nil
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if each := unhashableType(value.Field(i)); each != nil {
+				return each
+			}
+		}
+
+		return nil
+	}
+
+	if !value.Type().Comparable() {
+		return value.Type()
+	}
+
+	return nil
+}
+
+// validateHashableKey makes sure `key` can be used as map key, see `unhashableType()`
+func validateHashableKey(err *error, key reflect.Value) bool {
+	if each := unhashableType(key); each != nil {
+		*err = fmt.Errorf("key %v is not hashable, it holds value of non-comparable type %s", key.Interface(), each)
+		return false
+	}
+
+	return true
+}
+
+func indirectInterface(value reflect.Value) reflect.Value {
+	for value.IsValid() && value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+
+	return value
+}
+
+// joinKey returns the key to be indexed, and whether it's valid. Nil keys are not valid, they never match
+func joinKey(key reflect.Value) (reflect.Value, bool) {
+	key = indirectInterface(key)
+
+	switch key.Kind() {
+	case reflect.Invalid:
+		return key, false
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return key, !key.IsNil()
+	}
+
+	return key, true
+}
+
+// add puts the key into the index. Key which holds no non-comparable value is hashed, otherwise it's kept for deep equality match
+func (j *joinIndex) add(key reflect.Value, index int) {
+	key, ok := joinKey(key)
+	if !ok {
+		return
+	}
+
+	if unhashableType(key) == nil {
+		j.hashed[key.Interface()] = append(j.hashed[key.Interface()], index)
+		return
+	}
+
+	for i, entry := range j.unhashed {
+		if reflect.DeepEqual(entry.key.Interface(), key.Interface()) {
+			j.unhashed[i].indexes = append(entry.indexes, index)
+			return
+		}
+	}
+
+	j.unhashed = append(j.unhashed, joinIndexEntry{key: key, indexes: []int{index}})
+}
+
+func (j *joinIndex) lookup(key reflect.Value) []int {
+	key, ok := joinKey(key)
+	if !ok {
+		return nil
+	}
+
+	if unhashableType(key) == nil {
+		return j.hashed[key.Interface()]
+	}
+
+	for _, entry := range j.unhashed {
+		if reflect.DeepEqual(entry.key.Interface(), key.Interface()) {
+			return entry.indexes
+		}
+	}
+
+	return nil
+}
+
+// isNaNValue returns `true` if value holds a float NaN
+func isNaNValue(value reflect.Value) bool {
+	value = indirectValue(value)
+	if !value.IsValid() {
+		return false
+	}
+
+	switch value.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return math.IsNaN(value.Float())
+	}
+
+	return false
+}
+
+// numberAccumulator sums numbers of any numeric kind. Integers are summed exactly, switching to `math/big` on overflow.
+// Floats are summed using Neumaier compensated summation, or exactly using `big.Rat` if `isExact` is set.
+type numberAccumulator struct {
+	isExact bool
+	isFloat bool
+	count   int
+
+	intSum   int64
+	uintSum  uint64
+	bigSum   *big.Int
+	floatSum float64
+	floatErr float64
+	ratSum   *big.Rat
+}
+
+func (a *numberAccumulator) add(err *error, value reflect.Value) {
+	value = indirectValue(value)
+	a.count++
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number := value.Int()
+		if (number > 0 && a.intSum > math.MaxInt64-number) || (number < 0 && a.intSum < math.MinInt64-number) {
+			a.addBig(new(big.Int).SetInt64(a.intSum))
+			a.intSum = 0
+		}
+
+		a.intSum += number
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number := value.Uint()
+		if a.uintSum > math.MaxUint64-number {
+			a.addBig(new(big.Int).SetUint64(a.uintSum))
+			a.uintSum = 0
+		}
+
+		a.uintSum += number
+
+	case reflect.Float32, reflect.Float64:
+		number := value.Float()
+
+		if a.isExact {
+			rat, ok := new(big.Rat).SetString(strconv.FormatFloat(number, 'g', -1, 64))
+			if !ok {
+				*err = fmt.Errorf("cannot sum %v exactly", number)
+				return
+			}
+
+			if a.ratSum == nil {
+				a.ratSum = new(big.Rat)
+			}
+
+			a.ratSum.Add(a.ratSum, rat)
+			return
+		}
+
+		a.addFloat(number)
+
+	default:
+		a.count--
+		*err = fmt.Errorf("%v is not a number", value)
+	}
+}
+
+// addFloat adds float number using Neumaier compensated summation
+func (a *numberAccumulator) addFloat(number float64) {
+	sum := a.floatSum + number
+	if math.Abs(a.floatSum) >= math.Abs(number) {
+		a.floatErr += (a.floatSum - sum) + number
+	} else {
+		a.floatErr += (number - sum) + a.floatSum
+	}
+
+	a.floatSum = sum
+}
+
+func (a *numberAccumulator) addBig(number *big.Int) {
+	if a.bigSum == nil {
+		a.bigSum = new(big.Int)
+	}
+
+	a.bigSum.Add(a.bigSum, number)
+}
+
+func (a *numberAccumulator) integer() *big.Int {
+	result := new(big.Int).SetInt64(a.intSum)
+	result.Add(result, new(big.Int).SetUint64(a.uintSum))
+	if a.bigSum != nil {
+		result.Add(result, a.bigSum)
+	}
+
+	return result
+}
+
+func (a *numberAccumulator) float() float64 {
+	integer, _ := new(big.Float).SetInt(a.integer()).Float64()
+
+	if a.ratSum != nil {
+		ratFloat, _ := a.ratSum.Float64()
+		return integer + ratFloat
+	}
+
+	return integer + a.floatSum + a.floatErr
+}
+
+// mean computes the average of the added numbers. The integer part is divided exactly, then the compensated float part is added
+func (a *numberAccumulator) mean() float64 {
+	mean, _ := new(big.Rat).SetFrac(a.integer(), big.NewInt(int64(a.count))).Float64()
+	return mean + (a.floatSum+a.floatErr)/float64(a.count)
+}
+
+func (a *numberAccumulator) rat() *big.Rat {
+	result := new(big.Rat).SetInt(a.integer())
+	if a.ratSum != nil {
+		result.Add(result, a.ratSum)
+	}
+
+	if a.floatSum != 0 || a.floatErr != 0 {
+		result.Add(result, new(big.Rat).SetFloat64(a.floatSum+a.floatErr))
+	}
+
+	return result
+}
@@ -1,15 +1,145 @@
 package gubrak
 
 import (
+	"container/heap"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/novalagung/gubrak/v2/stats"
 )
 
+// AntiJoin function creates a slice of elements of `data` which have no matching key in `dataToJoin`. The order of result values is determined by `data`.
+//
+// Parameters
+//
+// This function requires three mandatory parameters:
+//  dataToJoin interface{} // ==> description: the slice to join with
+//  leftKey interface{}    // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `data`.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  rightKey interface{}   // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `dataToJoin`.
+//                         //                  the key data type should be same with the `leftKey` one.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) AntiJoin(dataToJoin, leftKey, rightKey interface{}) IChainable {
+	g.lastOperation = OperationAntiJoin
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _join(&err, g.data, dataToJoin, leftKey, rightKey, nil, joinAnti)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// BinByTime function places each element of `data` into time interval, e.g. hourly windows. The result is `[]TimeBucket` ordered by start time,
+// each bucket has its bounds and its members in original order. Only non-empty buckets are returned.
+// Truncation is based on the wall clock in the location of each key, so intervals which divide a day evenly (e.g. `time.Hour` or `24 * time.Hour`)
+// are aligned to the local midnight, even across daylight saving time changes.
+//
+// Parameters
+//
+// This function requires two mandatory parameters:
+//  interval time.Duration // ==> description: the length of each interval
+//  keyFn interface{}      // ==> type: `func(each anyType)time.Time`
+//                         // ==> description: the function to get the time of each element.
+//                         //                  if `nil`, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `[]TimeBucket`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `[]TimeBucket`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) BinByTime(interval time.Duration, keyFn interface{}) IChainable {
+	g.lastOperation = OperationBinByTime
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		buckets, _ := _binByTime(err, g.data, interval, keyFn)
+		return buckets
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Bucketize function places each element of `data` into numeric range, e.g. age brackets or price tiers. The result is `[]Bucket` in ascending order,
+// each bucket has its bounds and its members in original order. `n` edges make `n+1` buckets: `[-Inf, edges[0])`, `[edges[0], edges[1])`, ..., `[edges[n-1], +Inf)`.
+// Empty buckets are included.
+//
+// Parameters
+//
+// This function requires two mandatory parameters:
+//  edges []float64   // ==> description: the edges of the buckets, in strictly increasing order
+//  keyFn interface{} // ==> type: `func(each anyType)<number type>`
+//                    // ==> description: the function to get the number of each element.
+//                    //                  if `nil`, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `[]Bucket`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `[]Bucket`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Bucketize(edges []float64, keyFn interface{}) IChainable {
+	g.lastOperation = OperationBucketize
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _bucketize(err, g.data, edges, keyFn)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
 // Chunk function creates a slice of elements split into groups the length of `size`. If `data` can't be split evenly, the final chunk will be the remaining elements.
 //
 // Parameters
@@ -83,11 +213,46 @@ func (g *Chainable) Chunk(size int) IChainable {
 	return g.markResult(result)
 }
 
-// Compact function creates a slice with all falsey values removed from the `data`. These values: `false`, `nil`, `0`, `""`, `(*string)(nil)`, and other nil-able types are considered to be falsey.
+// OversizePolicy decides what `ChunkByWeight()` does with element which is heavier than the maximum weight
+type OversizePolicy int
+
+const (
+	// OversizeReturnError stops the operation with `*OversizeError`. This is the default policy.
+	OversizeReturnError OversizePolicy = iota
+
+	// OversizeOwnChunk puts the element into its own chunk
+	OversizeOwnChunk
+
+	// OversizeDrop leaves the element out of the result
+	OversizeDrop
+)
+
+// OversizeError is the error returned by `ChunkByWeight()` with `OversizeReturnError` policy
+type OversizeError struct {
+	Index     int
+	Weight    float64
+	MaxWeight float64
+}
+
+func (e *OversizeError) Error() string {
+	return fmt.Sprintf("weight of element at index %d is %v, which exceeds max weight %v", e.Index, e.Weight, e.MaxWeight)
+}
+
+// ChunkByWeight function creates a slice of elements split into groups, each group has total weight not exceeding `maxWeight`, e.g. to batch requests by their size in bytes.
+// Consecutive elements are packed greedily, a new group starts when the next element doesn't fit into the current group.
 //
 // Parameters
 //
-// This function does not requires any parameter.
+// This function requires two mandatory parameters, and one optional parameter:
+//  maxWeight float64     // ==> description: the maximum total weight of each group
+//  weightFn interface{}  // ==> type: `func(each anyType)<number type>`
+//                        // ==> description: the function to get the weight of each element. the weight should not be negative.
+//                        //                  if `nil`, the element itself is the weight.
+//  policy OversizePolicy // ==> optional
+//                        //     description: decides what to do with element which is heavier than `maxWeight`.
+//                        //                  `OversizeReturnError`, `OversizeOwnChunk` or `OversizeDrop`.
+//                        //                  with `OversizeReturnError`, the error is `*OversizeError`.
+//                        //     default value: `OversizeReturnError`
 //
 // Return values
 //
@@ -100,8 +265,8 @@ func (g *Chainable) Chunk(size int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Compact() IChainable {
-	g.lastOperation = OperationCompact
+func (g *Chainable) ChunkByWeight(maxWeight float64, weightFn interface{}, policy ...OversizePolicy) IChainable {
+	g.lastOperation = OperationChunkByWeight
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -114,66 +279,72 @@ func (g *Chainable) Compact() IChainable {
 			return nil
 		}
 
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+		dataValue, _, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
 			return nil
 		}
 
-		result := makeSlice(dataType)
+		if !(maxWeight > 0) {
+			*err = errors.New("max weight should be greater than zero")
+			return nil
+		}
 
-		if dataValueLen == 0 {
-			return result.Interface()
+		weightOf := elementIteratee(err, "element", dataValue.Type().Elem(), []interface{}{weightFn}, true)
+		if *err != nil {
+			return nil
 		}
 
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			target := each
+		oversizePolicy := OversizeReturnError
+		if len(policy) > 0 {
+			oversizePolicy = policy[0]
+		}
 
-			if target.Kind() == reflect.Ptr || target.Kind() == reflect.Interface {
-				if target.IsNil() {
-					return
-				}
+		sliceType := reflect.SliceOf(dataValue.Type().Elem())
+		result := makeSlice(reflect.SliceOf(sliceType))
+		chunk, chunkWeight := makeSlice(sliceType), 0.0
 
-				target = target.Elem()
+		flush := func() {
+			if chunk.Len() > 0 {
+				result = reflect.Append(result, chunk)
+				chunk, chunkWeight = makeSlice(sliceType), 0
 			}
+		}
 
-			if target.Kind() == reflect.Ptr {
-				if target.IsNil() {
-					return
-				}
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			weight := numberToFloat(indirectValue(weightOf(each)))
+			if weight < 0 || math.IsNaN(weight) {
+				*err = fmt.Errorf("weight of element at index %d should not be negative", i)
+				return false
 			}
 
-			ok := false
-
-			switch target.Kind() {
-
-			case reflect.Bool:
-				ok = target.Bool()
-
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				ok = target.Int() != 0
-
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-				ok = target.Uint() != 0
-
-			case reflect.Float32, reflect.Float64:
-				ok = target.Float() != 0
-
-			case reflect.Complex64, reflect.Complex128:
-				ok = target.Complex() != 0
-
-			case reflect.String:
-				ok = target.String() != ""
+			if weight > maxWeight {
+				switch oversizePolicy {
+				case OversizeOwnChunk:
+					flush()
+					result = reflect.Append(result, reflect.Append(makeSlice(sliceType), each))
+				case OversizeDrop:
+				default:
+					*err = &OversizeError{Index: i, Weight: weight, MaxWeight: maxWeight}
+					return false
+				}
 
-			default: // case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.Struct, reflect.UnsafePointer:
-				ok = !target.IsNil()
+				return true
 			}
 
-			if ok {
-				result = reflect.Append(result, each)
+			if chunkWeight+weight > maxWeight {
+				flush()
 			}
+
+			chunk = reflect.Append(chunk, each)
+			chunkWeight += weight
+			return true
 		})
+		if *err != nil {
+			return nil
+		}
 
+		flush()
 		return result.Interface()
 	}(&err)
 	if err != nil {
@@ -183,12 +354,13 @@ func (g *Chainable) Compact() IChainable {
 	return g.markResult(result)
 }
 
-// Concat function creates a new slice concatenating `data` with any additional slice.
+// ChunkWhile function splits `data` into chunks of consecutive elements, a chunk continues as long as predicate returns `true` for each two consecutive elements.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  sliceToConcat interface{} // ==> description: the slice to concatenate
+//  predicate interface{} // ==> type: `func(previous anyType, current anyType)bool`
+//                        // ==> description: the function to decide whether `current` belongs to the same chunk with `previous`
 //
 // Return values
 //
@@ -201,50 +373,18 @@ func (g *Chainable) Compact() IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Concat(sliceToConcat interface{}) IChainable {
-	g.lastOperation = OperationConcat
+func (g *Chainable) ChunkWhile(predicate interface{}) IChainable {
+	g.lastOperation = OperationChunkWhile
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := _concat(&err, g.data, sliceToConcat)
-	if err != nil {
-		return g.markError(result, err)
-	}
-
-	return g.markResult(result)
-}
-
-// ConcatMany function creates a new slice concatenating `data` with any additional slices (the 2nd parameter and rest).
-//
-// Parameters
-//
-// This function requires optional variadic parameters:
-//  sliceToConcat1 interface{} // ==> description: the slice to concatenate
-//  sliceToConcat2 interface{} // ==> description: the slice to concatenate
-//  sliceToConcat3 interface{} // ==> description: the slice to concatenate
-//  ...
-//
-// Return values
-//
-// Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                         // ==> description: returns error object
-//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
-//
-// Examples
-//
-// List of examples available:
-func (g *Chainable) ConcatMany(slicesToConcat ...interface{}) IChainable {
-	g.lastOperation = OperationConcatMany
-	if g.IsError() || g.shouldReturn() {
-		return g
-	}
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-	err := (error)(nil)
-	result := _concat(&err, g.data, slicesToConcat...)
+		return _splitBetween(err, g.data, predicate, false)
+	}(&err)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -252,339 +392,208 @@ func (g *Chainable) ConcatMany(slicesToConcat ...interface{}) IChainable {
 	return g.markResult(result)
 }
 
-func _concat(err *error, data interface{}, slicesToConcat ...interface{}) interface{} {
-	defer catch(err)
-
+// _splitBetween splits `data` between two consecutive elements when predicate returns `splitOn`
+func _splitBetween(err *error, data, predicate interface{}, splitOn bool) interface{} {
 	if !isNonNilData(err, "data", data) {
 		return nil
 	}
 
-	dataValue, dataType, _, dataValueLen := inspectData(data)
+	dataValue, _, _, dataValueLen := inspectData(data)
 
 	if !isSlice(err, "data", dataValue) {
 		return nil
 	}
 
-	result := makeSlice(dataType)
-
-	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		result = reflect.Append(result, each)
-	})
-
-	for i, eachConcatenableData := range slicesToConcat {
-		eachLabel := fmt.Sprintf("concat data %d", (i + 1))
-		eachValue, eachType, _, eachValueLen := inspectData(eachConcatenableData)
+	elemType := dataValue.Type().Elem()
+	predicateValue := inspectFuncWithInputs(err, "predicate", predicate, elemType, elemType)
+	if *err != nil {
+		return nil
+	}
 
-		if !isSlice(err, eachLabel, eachValue) {
-			return nil
-		}
+	if predicateValue.Type().Out(0).Kind() != reflect.Bool {
+		*err = errors.New("predicate return value data type should be bool")
+		return nil
+	}
 
-		if !isTypeEqual(err, "data", dataType.Elem(), eachLabel, eachType.Elem()) {
-			return nil
-		}
+	sliceType := reflect.SliceOf(elemType)
+	result := makeSlice(reflect.SliceOf(sliceType))
+	if dataValueLen == 0 {
+		return result.Interface()
+	}
 
-		if eachValueLen == 0 {
-			continue
+	chunk := makeSlice(sliceType)
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		if i > 0 && predicateValue.Call([]reflect.Value{dataValue.Index(i - 1), each})[0].Bool() == splitOn {
+			result = reflect.Append(result, chunk)
+			chunk = makeSlice(sliceType)
 		}
 
-		forEachSlice(eachValue, eachValueLen, func(each reflect.Value, i int) {
-			result = reflect.Append(result, each)
-		})
-	}
+		chunk = reflect.Append(chunk, each)
+	})
 
-	return result.Interface()
+	return reflect.Append(result, chunk).Interface()
 }
 
-// Contains function checks if value is in data. If data is a string, it's checked for a substring of value, otherwise SameValueZero is used for equality comparisons. If `fromIndex` is negative, it's used as the offset from the end of data.
+// Classify function splits elements of `data` into multiple classes, one for each predicate, plus the remainder. Each element is assigned to the class of the first predicate
+// which returns truthy for it, the next predicates are not invoked. Elements which no predicate returns truthy for are assigned to the remainder, the last class.
+// Each class is a slice with the same data type as `data`.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  search interface{} // ==> description: the value to search for.
-//  fromIndex int      // ==> optional
-//                     //     description: The index to search from
-//                     //     default value: 0
+// This function requires optional variadic parameters:
+//  predicate1 interface{} // ==> type: `func(each anyType, i int)bool` or
+//                         //           `*Expression`, e.g. `Expr("Age >= 18")`
+//                         // ==> description: the function invoked per iteration.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  predicate2 interface{} // ==> description: the next predicate
+//  predicate3 interface{} // ==> description: the next predicate
+//  ...
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() bool                  // ==> description: returns true if value is found, else false
-//  .ResultAndError() (bool, error) // ==> description: returns true if value is found, else false, and error object
-//  .Error() error                  // ==> description: returns error object
-//  .IsError() bool                 // ==> description: return `true` on error, otherwise `false`
+//  .ResultAt(i int) interface{}                // ==> description: return slice of elements of the i-th class, the class of the i-th predicate
+//  .ResultRemainder() interface{}              // ==> description: return slice of elements which no predicate returns truthy for
+//  .Len() int                                  // ==> description: return the number of classes, the number of predicates plus one
+//  .Result() []interface{}                     // ==> description: return all classes, the remainder is the last one
+//  .ResultAndError() ([]interface{}, error)    // ==> description: return all classes, and error object
+//  .Error() error                              // ==> description: returns error object
+//  .IsError() bool                             // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Contains(search interface{}, args ...int) IChainableBoolResult {
-	g.lastOperation = OperationContains
+func (g *Chainable) Classify(predicates ...interface{}) IChainableMultipleReturnValueResult {
+	g.lastOperation = OperationClassify
 	if g.IsError() || g.shouldReturn() {
-		return &resultContains{chainable: g}
+		return &resultClassify{chainable: g}
 	}
 
 	err := (error)(nil)
-	result := func(err *error) bool {
+	result := func(err *error) []interface{} {
 		defer catch(err)
 
-		if dataValue, dataOK := g.data.(string); dataOK {
-			if searchValue, searchOK := search.(string); searchOK {
-				return strings.Contains(dataValue, searchValue)
-			}
-
-			return false
-		}
-
 		if !isNonNilData(err, "data", g.data) {
-			return false
-		}
-
-		dataValue, _, dataValueKind, dataValueLen := inspectData(g.data)
-
-		startIndex := 0
-		if len(args) > 0 {
-			startIndex = args[0]
+			return nil
 		}
 
-		if !isZeroOrPositiveNumber(err, "start index", startIndex) {
-			return false
-		}
+		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
-			if dataValueKind == reflect.Map {
-				*err = nil
-				return _containsCollection(err, dataValue, search, startIndex)
-			}
-
-			*err = errors.New((*err).Error() + ", map, or a string")
-			return false
+			return nil
 		}
 
-		return _containsSlice(err, dataValue, dataValueLen, search, startIndex)
-	}(&err)
-	if err != nil {
-		return &resultContains{chainable: g.markError(result, err)}
-	}
+		predicateValues := make([]reflect.Value, len(predicates))
+		predicateTypeNumIns := make([]int, len(predicates))
+		for k, predicate := range predicates {
+			predicate = bindExpression(err, predicate, dataValue, true)
+			if *err != nil {
+				return nil
+			}
 
-	return &resultContains{chainable: g.markResult(result)}
-}
+			predicateValue, predicateType := inspectFunc(err, predicate)
+			if *err != nil {
+				*err = fmt.Errorf("predicate %d: %s", k, (*err).Error())
+				return nil
+			}
 
-func _containsSlice(err *error, dataValue reflect.Value, dataValueLen int, search interface{}, startIndex int) bool {
-	isFound := false
+			predicateTypeNumIns[k] = validateFuncInputForSliceLoop(err, predicateType, dataValue)
+			if *err == nil {
+				validateFuncOutputOneVarBool(err, predicateType, true)
+			}
+			if *err != nil {
+				*err = fmt.Errorf("predicate %d: %s", k, (*err).Error())
+				return nil
+			}
 
-	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-		if i < startIndex {
-			return true
+			predicateValues[k] = predicateValue
 		}
 
-		eachActualValue := each.Interface()
-
-		if eachActualValue == search {
-			isFound = true
-			return false
+		classes := make([]reflect.Value, len(predicates)+1)
+		for k := range classes {
+			classes[k] = makeSlice(dataValueType)
 		}
 
-		return true
-	})
-
-	return isFound
-}
-
-func _containsCollection(err *error, dataValue reflect.Value, search interface{}, startIndex int) bool {
-	isFound := false
-	counter := 0
-
-	dataValueMapKeys := dataValue.MapKeys()
-	forEachCollectionStoppable(dataValue, dataValueMapKeys, func(value reflect.Value, key reflect.Value, i int) bool {
-		defer func() {
-			counter++
-		}()
-
-		if counter < startIndex {
-			return true
-		}
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			class := len(predicates)
+			for k, predicateValue := range predicateValues {
+				if callFuncSliceLoop(predicateValue, each, i, predicateTypeNumIns[k])[0].Bool() {
+					class = k
+					break
+				}
+			}
 
-		eachActualValue := value.Interface()
+			classes[class] = reflect.Append(classes[class], each)
+		})
 
-		if eachActualValue == search && !isFound {
-			isFound = true
-			return false
+		result := make([]interface{}, len(classes))
+		for k, class := range classes {
+			result[k] = class.Interface()
 		}
 
-		return true
-	})
-
-	return isFound
-}
-
-// Count get the length of `data`.
-//
-// Parameters
-//
-// This function does not requires any parameter.
-//
-// Return values
-//
-// Chain with these methods to get result:
-//  .Result() int                  // ==> description: returns length of data
-//  .ResultAndError() (int, error) // ==> description: returns length of data, and error object
-//  .Error() error                 // ==> description: returns error object
-//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
-//
-// Examples
-//
-// List of examples available:
-func (g *Chainable) Count() IChainableNumberResult {
-	g.lastOperation = OperationCount
-	if g.IsError() || g.shouldReturn() {
-		return &resultCount{chainable: g}
-	}
-
-	err := (error)(nil)
-	result := _count(&err, g.data, nil)
+		return result
+	}(&err)
 	if err != nil {
-		return &resultCount{chainable: g.markError(result, err)}
+		return &resultClassify{chainable: g.markError(result, err)}
 	}
 
-	return &resultCount{chainable: g.markResult(result)}
+	return &resultClassify{chainable: g.markResult(result)}
 }
 
-// CountBy get the length of `data` filtered by `iteratee`.
+// Combinations function creates all `k`-length combinations of elements of `data`, as slice of tuples, in lexicographic order of the element position.
+// The number of tuples is limited by `CombinatoricsLimit`, use `CombinationsIterator()` to generate the tuples one by one instead.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  iteratee interface{} // ==> type: `func(each anyType, i int)bool` or
-//                       //           `func(value anyType, key anyType, i int)bool`
-//                       //     description: the function invoked per iteration
+// This function requires one mandatory parameter, and one optional parameter:
+//  k int                    // ==> description: the length of each tuple
+//  limit CombinatoricsLimit // ==> description: the maximum number of tuples, `DefaultCombinatoricsLimit` if not provided.
+//                           //                  zero or negative number disables the limit.
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() int                  // ==> description: returns the result after operation
-//  .ResultAndError() (int, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                 // ==> description: returns error object
-//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) CountBy(iteratee interface{}) IChainableNumberResult {
-	g.lastOperation = OperationCountBy
+func (g *Chainable) Combinations(k int, limit ...CombinatoricsLimit) IChainable {
+	g.lastOperation = OperationCombinations
 	if g.IsError() || g.shouldReturn() {
-		return &resultCount{chainable: g}
+		return g
 	}
 
 	err := (error)(nil)
-	result := _count(&err, g.data, iteratee)
-	if err != nil {
-		return &resultCount{chainable: g.markError(result, err)}
-	}
-
-	return &resultCount{chainable: g.markResult(result)}
-}
-
-func _count(err *error, data, predicate interface{}) int {
-	defer catch(err)
-
-	if !isNonNilData(err, "data", data) {
-		return 0
-	}
-
-	dataValue, dataValueType, dataValueKind, dataValueLen := inspectData(data)
-
-	if !isSlice(err, "data", dataValue) {
-		if dataValueKind == reflect.Map {
-			*err = nil
-			return _countCollection(err, dataValue, dataValueType, dataValueKind, dataValueLen, predicate)
-		}
-
-		return 0
-	}
-
-	return _countSlice(err, dataValue, dataValueType, dataValueKind, dataValueLen, predicate)
-}
-
-func _countSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}) int {
-
-	var callbackValue reflect.Value
-	var callbackType reflect.Type
-	var callbackTypeNumIn int
-
-	if callback == nil {
-		return dataValueLen
-	}
-
-	callbackValue, callbackType = inspectFunc(err, callback)
-	if *err != nil {
-		return 0
-	}
-
-	callbackTypeNumIn = validateFuncInputForSliceLoop(err, callbackType, dataValue)
-	if *err != nil {
-		return 0
-	}
-
-	validateFuncOutputOneVarBool(err, callbackType, true)
-	if *err != nil {
-		return 0
-	}
-
-	resultCounter := 0
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-		if res[0].Bool() {
-			resultCounter++
+		it, count := _combinationsIterator(err, g.data, k)
+		if *err != nil {
+			return nil
 		}
-	})
 
-	return resultCounter
-}
-
-func _countCollection(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}) int {
-
-	var callbackValue reflect.Value
-	var callbackType reflect.Type
-	var callbackTypeNumIn int
-
-	if callback == nil {
-		return dataValueLen
-	}
-
-	callbackValue, callbackType = inspectFunc(err, callback)
-	if *err != nil {
-		return 0
-	}
-
-	callbackTypeNumIn = validateFuncInputForCollectionLoop(err, callbackType, dataValue)
-	if *err != nil {
-		return 0
-	}
-
-	validateFuncOutputOneVarBool(err, callbackType, true)
-	if *err != nil {
-		return 0
+		return _combinatoricsCollect(err, it, count, _combinatoricsLimit(limit))
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
 	}
 
-	resultCounter := 0
-
-	dataValueMapKeys := dataValue.MapKeys()
-	forEachCollection(dataValue, dataValueMapKeys, func(value, key reflect.Value, i int) {
-		res := callFuncCollectionLoop(callbackValue, value, key, callbackTypeNumIn)
-		if res[0].Bool() {
-			resultCounter++
-		}
-	})
-
-	return resultCounter
+	return g.markResult(result)
 }
 
-// Difference function creates a slice of `data` that values not included in the other given slice. The order and references of result values are determined by the first slice.
+// CombinationsWithReplacement function creates all `k`-length combinations of elements of `data` where each element can be repeated, as slice of tuples, in lexicographic order of the element position.
+// The number of tuples is limited by `CombinatoricsLimit`, use `CombinationsWithReplacementIterator()` to generate the tuples one by one instead.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  dataToCompare interface{} // ==> description: the slice to differentiate
+// This function requires one mandatory parameter, and one optional parameter:
+//  k int                    // ==> description: the length of each tuple
+//  limit CombinatoricsLimit // ==> description: the maximum number of tuples, `DefaultCombinatoricsLimit` if not provided.
+//                           //                  zero or negative number disables the limit.
 //
 // Return values
 //
@@ -597,14 +606,23 @@ func _countCollection(err *error, dataValue reflect.Value, dataValueType reflect
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Difference(dataToCompare interface{}) IChainable {
-	g.lastOperation = OperationDifferenceMany
+func (g *Chainable) CombinationsWithReplacement(k int, limit ...CombinatoricsLimit) IChainable {
+	g.lastOperation = OperationCombinationsWithReplacement
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := _difference(&err, g.data, dataToCompare)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		it, count := _combinationsWithReplacementIterator(err, g.data, k)
+		if *err != nil {
+			return nil
+		}
+
+		return _combinatoricsCollect(err, it, count, _combinatoricsLimit(limit))
+	}(&err)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -612,15 +630,11 @@ func (g *Chainable) Difference(dataToCompare interface{}) IChainable {
 	return g.markResult(result)
 }
 
-// DifferenceMany function creates a slice of `data` that values not included in the other given slices. The order and references of result values are determined by the first slice.
+// Compact function creates a slice with all falsey values removed from the `data`. These values: `false`, `nil`, `0`, `""`, `(*string)(nil)`, and other nil-able types are considered to be falsey.
 //
 // Parameters
 //
-// This function requires optional variadic parameters:
-//  datasToCompare1 interface{} // ==> description: the slice to differentiate
-//  datasToCompare2 interface{} // ==> description: the slice to differentiate
-//  datasToCompare3 interface{} // ==> description: the slice to differentiate
-//  ...
+// This function does not requires any parameter.
 //
 // Return values
 //
@@ -633,88 +647,95 @@ func (g *Chainable) Difference(dataToCompare interface{}) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) DifferenceMany(datasToCompare ...interface{}) IChainable {
-	g.lastOperation = OperationDifferenceMany
+func (g *Chainable) Compact() IChainable {
+	g.lastOperation = OperationCompact
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
-	if len(datasToCompare) == 0 {
-		return g.markError(nil, errors.New("data to compare cannot be empty"))
-	}
-
 	err := (error)(nil)
-	result := _difference(&err, g.data, datasToCompare...)
-	if err != nil {
-		return g.markError(result, err)
-	}
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-	return g.markResult(result)
-}
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
 
-func _difference(err *error, data interface{}, dataToCompare ...interface{}) interface{} {
-	defer catch(err)
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
 
-	if !isNonNilData(err, "data", data) {
-		return nil
-	}
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
 
-	dataValue, dataType, _, dataValueLen := inspectData(data)
+		result := makeSlice(dataType)
 
-	if !isSlice(err, "data", dataValue) {
-		return nil
-	}
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
 
-	result := makeSlice(dataType)
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			target := each
 
-	if dataValueLen == 0 {
-		return result.Interface()
-	}
+			if target.Kind() == reflect.Ptr || target.Kind() == reflect.Interface {
+				if target.IsNil() {
+					return
+				}
 
-	dataToCompareMap := make(map[reflect.Value]int)
-	for i, each := range dataToCompare {
-		eachLabel := fmt.Sprintf("difference data %d", (i + 1))
-		eachValue, eachType, _, eachValueLen := inspectData(each)
+				target = target.Elem()
+			}
 
-		if !isSlice(err, eachLabel, eachValue) {
-			return nil
-		}
+			if target.Kind() == reflect.Ptr {
+				if target.IsNil() {
+					return
+				}
+			}
 
-		if !isTypeEqual(err, "data", dataType, eachLabel, eachType) {
-			return nil
-		}
+			ok := false
 
-		dataToCompareMap[eachValue] = eachValueLen
-	}
+			switch target.Kind() {
 
-	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		isFound := false
+			case reflect.Bool:
+				ok = target.Bool()
 
-		for compareValue, compareValueLen := range dataToCompareMap {
-			forEachSliceStoppable(compareValue, compareValueLen, func(inner reflect.Value, j int) bool {
-				if each.Interface() == inner.Interface() {
-					isFound = true
-					return false
-				}
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				ok = target.Int() != 0
 
-				return true
-			})
-		}
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				ok = target.Uint() != 0
 
-		if !isFound {
-			result = reflect.Append(result, each)
-		}
-	})
+			case reflect.Float32, reflect.Float64:
+				ok = target.Float() != 0
 
-	return result.Interface()
+			case reflect.Complex64, reflect.Complex128:
+				ok = target.Complex() != 0
+
+			case reflect.String:
+				ok = target.String() != ""
+
+			default: // case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.Struct, reflect.UnsafePointer:
+				ok = !target.IsNil()
+			}
+
+			if ok {
+				result = reflect.Append(result, each)
+			}
+		})
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
 }
 
-// Drop function creates a slice of `data` with `n` elements dropped from the beginning.
+// Concat function creates a new slice concatenating `data` with any additional slice.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  size int // ==> description: the number of elements to drop
+//  sliceToConcat interface{} // ==> description: the slice to concatenate
 //
 // Return values
 //
@@ -727,50 +748,14 @@ func _difference(err *error, data interface{}, dataToCompare ...interface{}) int
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Drop(size int) IChainable {
-	g.lastOperation = OperationDrop
+func (g *Chainable) Concat(sliceToConcat interface{}) IChainable {
+	g.lastOperation = OperationConcat
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) interface{} {
-		defer catch(err)
-
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
-
-		if !isZeroOrPositiveNumber(err, "size", size) {
-			return g.data
-		}
-
-		if size == 0 {
-			return g.data
-		}
-
-		result := makeSlice(dataType)
-
-		if dataValueLen == 0 {
-			return result.Interface()
-		}
-
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			if i < size {
-				return
-			}
-
-			result = reflect.Append(result, each)
-		})
-
-		return result.Interface()
-	}(&err)
+	result := _concat(&err, g.data, sliceToConcat)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -778,12 +763,15 @@ func (g *Chainable) Drop(size int) IChainable {
 	return g.markResult(result)
 }
 
-// DropRight function creates a slice of `data` with `n` elements dropped from the end.
+// ConcatMany function creates a new slice concatenating `data` with any additional slices (the 2nd parameter and rest).
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  size int // ==> description: the number of elements to drop
+// This function requires optional variadic parameters:
+//  sliceToConcat1 interface{} // ==> description: the slice to concatenate
+//  sliceToConcat2 interface{} // ==> description: the slice to concatenate
+//  sliceToConcat3 interface{} // ==> description: the slice to concatenate
+//  ...
 //
 // Return values
 //
@@ -796,370 +784,334 @@ func (g *Chainable) Drop(size int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) DropRight(size int) IChainable {
-	g.lastOperation = OperationDropRight
+func (g *Chainable) ConcatMany(slicesToConcat ...interface{}) IChainable {
+	g.lastOperation = OperationConcatMany
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) interface{} {
-		defer catch(err)
+	result := _concat(&err, g.data, slicesToConcat...)
+	if err != nil {
+		return g.markError(result, err)
+	}
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
+	return g.markResult(result)
+}
 
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+func _concat(err *error, data interface{}, slicesToConcat ...interface{}) interface{} {
+	defer catch(err)
 
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
 
-		if !isZeroOrPositiveNumber(err, "size", size) {
-			return g.data
-		}
+	dataValue, dataType, _, dataValueLen := inspectData(data)
 
-		if size == 0 {
-			return g.data
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	result := makeSlice(dataType)
+
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		result = reflect.Append(result, each)
+	})
+
+	for i, eachConcatenableData := range slicesToConcat {
+		eachLabel := fmt.Sprintf("concat data %d", (i + 1))
+		eachValue, eachType, _, eachValueLen := inspectData(eachConcatenableData)
+
+		if !isSlice(err, eachLabel, eachValue) {
+			return nil
 		}
 
-		result := makeSlice(dataType)
+		if !isTypeEqual(err, "data", dataType.Elem(), eachLabel, eachType.Elem()) {
+			return nil
+		}
 
-		if dataValueLen == 0 {
-			return result.Interface()
+		if eachValueLen == 0 {
+			continue
 		}
 
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			if i < (dataValueLen - size) {
-				result = reflect.Append(result, each)
-			}
+		forEachSlice(eachValue, eachValueLen, func(each reflect.Value, i int) {
+			result = reflect.Append(result, each)
 		})
-
-		return result.Interface()
-	}(&err)
-	if err != nil {
-		return g.markError(result, err)
 	}
 
-	return g.markResult(result)
+	return result.Interface()
 }
 
-// Each iterates over elements of `data` and invokes `iteratee` for each element. Iteratee functions may exit iteration early by explicitly returning false
+// Contains function checks if value is in data. If data is a string, it's checked for a substring of value, otherwise SameValueZero is used for equality comparisons. If `fromIndex` is negative, it's used as the offset from the end of data.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  iteratee interface{} // ==> type: `func(each anyType, i int)` or
-//                       //           `func(each anyType, i int)bool` or
-//                       //           `func(value anyType, key anyType, i int)` or
-//                       //           `func(value anyType, key anyType, i int)bool`
-//                       // ==> description: the function invoked per iteration.
-//                       //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                       //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                       //                  and both are optional.
-//                       //                  if return value is provided then the next iteration is controlled by returned value.
-//                       //                  `return true` will make the iteration continue, meanwhile `return false` will stop it
+//  search interface{} // ==> description: the value to search for.
+//  fromIndex int      // ==> optional
+//                     //     description: The index to search from
+//                     //     default value: 0
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Error() error  // ==> description: returns error object
-//  .IsError() bool // ==> description: return `true` on error, otherwise `false`
+//  .Result() bool                  // ==> description: returns true if value is found, else false
+//  .ResultAndError() (bool, error) // ==> description: returns true if value is found, else false, and error object
+//  .Error() error                  // ==> description: returns error object
+//  .IsError() bool                 // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Each(iteratee interface{}) IChainableNoReturnValueResult {
-	g.lastOperation = OperationEach
+func (g *Chainable) Contains(search interface{}, args ...int) IChainableBoolResult {
+	g.lastOperation = OperationContains
 	if g.IsError() || g.shouldReturn() {
-		return &resultEach{chainable: g}
+		return &resultContains{chainable: g}
 	}
 
 	err := (error)(nil)
-	_each(&err, g.data, iteratee, true)
-	if err != nil {
-		return &resultEach{chainable: g.markError(nil, err)}
-	}
+	result := func(err *error) bool {
+		defer catch(err)
 
-	return &resultEach{chainable: g.markResult(nil)}
-}
+		if dataValue, dataOK := g.data.(string); dataOK {
+			if searchValue, searchOK := search.(string); searchOK {
+				return strings.Contains(dataValue, searchValue)
+			}
 
-// EachRight iterates over elements of `data` from tail to head, and invokes `iteratee` for each element. Iteratee functions may exit iteration early by explicitly returning false
-//
-// Parameters
-//
-// This function requires single mandatory parameter:
-//  iteratee interface{} // ==> type: `func(each anyType, i int)` or
-//                       //           `func(each anyType, i int)bool` or
-//                       //           `func(value anyType, key anyType, i int)` or
-//                       //           `func(value anyType, key anyType, i int)bool`
-//                       // ==> description: the function invoked per iteration.
-//                       //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                       //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                       //                  and both are optional.
-//                       //                  if return value is provided then the next iteration is controlled by returned value.
-//                       //                  `return true` will make the iteration continue, meanwhile `return false` will stop it
-//
-// Return values
-//
-// Chain with these methods to get result:
-//  .Error() error  // ==> description: returns error object
-//  .IsError() bool // ==> description: return `true` on error, otherwise `false`
-//
-// Examples
-//
-// List of examples available:
-func (g *Chainable) EachRight(iteratee interface{}) IChainableNoReturnValueResult {
-	g.lastOperation = OperationEachRight
-	if g.IsError() || g.shouldReturn() {
-		return &resultEach{chainable: g}
-	}
+			return false
+		}
 
-	err := (error)(nil)
-	_each(&err, g.data, iteratee, true)
-	if err != nil {
-		return &resultEach{chainable: g.markError(nil, err)}
-	}
+		if !isNonNilData(err, "data", g.data) {
+			return false
+		}
 
-	return &resultEach{chainable: g.markResult(nil)}
-}
+		dataValue, _, dataValueKind, dataValueLen := inspectData(g.data)
 
-func _each(err *error, data, iteratee interface{}, isForward bool) {
-	defer catch(err)
+		startIndex := 0
+		if len(args) > 0 {
+			startIndex = args[0]
+		}
 
-	if !isNonNilData(err, "data", data) {
-		return
-	}
+		if !isZeroOrPositiveNumber(err, "start index", startIndex) {
+			return false
+		}
 
-	dataValue, dataValueType, dataValueKind, dataValueLen := inspectData(data)
+		if !isSlice(err, "data", dataValue) {
+			if dataValueKind == reflect.Map {
+				*err = nil
+				return _containsCollection(err, dataValue, search, startIndex)
+			}
 
-	if !isSlice(err, "data", dataValue) {
-		if dataValueKind == reflect.Map {
-			*err = nil
-			_eachCollection(err, dataValue, dataValueType, dataValueKind, dataValueLen, iteratee, isForward)
+			*err = errors.New((*err).Error() + ", map, or a string")
+			return false
 		}
 
-		return
+		return _containsSlice(err, dataValue, dataValueLen, search, startIndex)
+	}(&err)
+	if err != nil {
+		return &resultContains{chainable: g.markError(result, err)}
 	}
 
-	_eachSlice(err, dataValue, dataValueType, dataValueKind, dataValueLen, iteratee, isForward)
+	return &resultContains{chainable: g.markResult(result)}
 }
 
-func _eachSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}, isLoopIncremental bool) {
-	callbackValue, callbackType := inspectFunc(err, callback)
-	if *err != nil {
-		return
-	}
-
-	callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-	if *err != nil {
-		return
-	}
-
-	validateFuncOutputOneVarBool(err, callbackType, false)
-	if *err != nil {
-		return
-	}
-
-	if dataValueLen == 0 {
-		return
-	}
+func _containsSlice(err *error, dataValue reflect.Value, dataValueLen int, search interface{}, startIndex int) bool {
+	isFound := false
 
 	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-		var res []reflect.Value
-		if isLoopIncremental {
-			res = callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-		} else {
-			indexFromRight := dataValueLen - i - 1
-			eachFromRight := dataValue.Index(indexFromRight)
-			res = callFuncSliceLoop(callbackValue, eachFromRight, indexFromRight, callbackTypeNumIn)
+		if i < startIndex {
+			return true
 		}
 
-		if len(res) > 0 {
-			return res[0].Bool()
+		eachActualValue := each.Interface()
+
+		if eachActualValue == search {
+			isFound = true
+			return false
 		}
 
 		return true
 	})
-}
-
-func _eachCollection(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}, isLoopIncremental bool) {
-	callbackValue, callbackType := inspectFunc(err, callback)
-	if *err != nil {
-		return
-	}
 
-	callbackTypeNumIn := validateFuncInputForCollectionLoop(err, callbackType, dataValue)
-	if *err != nil {
-		return
-	}
-
-	validateFuncOutputNone(err, callbackType)
-	if *err != nil {
-		return
-	}
+	return isFound
+}
 
-	if dataValueLen == 0 {
-		return
-	}
+func _containsCollection(err *error, dataValue reflect.Value, search interface{}, startIndex int) bool {
+	isFound := false
+	counter := 0
 
 	dataValueMapKeys := dataValue.MapKeys()
 	forEachCollectionStoppable(dataValue, dataValueMapKeys, func(value reflect.Value, key reflect.Value, i int) bool {
-		var res []reflect.Value
-		if isLoopIncremental {
-			res = callFuncCollectionLoop(callbackValue, value, key, callbackTypeNumIn)
-		} else {
-			indexFromRight := dataValueLen - i - 1
-			keyFromRight := dataValueMapKeys[indexFromRight]
-			valueFromRight := dataValue.MapIndex(keyFromRight)
-			res = callFuncCollectionLoop(callbackValue, valueFromRight, keyFromRight, callbackTypeNumIn)
+		defer func() {
+			counter++
+		}()
+
+		if counter < startIndex {
+			return true
 		}
 
-		if len(res) > 0 {
-			return res[0].Bool()
+		eachActualValue := value.Interface()
+
+		if eachActualValue == search && !isFound {
+			isFound = true
+			return false
 		}
 
 		return true
 	})
+
+	return isFound
 }
 
-// Exclude function removes value from `data`.
+// Correlation function computes the Pearson correlation coefficient between two numbers taken from each element of `data`. Use `Correlation()` package function to compute it between two numeric slices.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  itemToExclude interface{} // ==> description: the item to exclude
+// This function requires two mandatory parameters:
+//  iterateeX interface{} // ==> type: `func(each anyType)<number type>`
+//                        // ==> description: the function to get the first number of each element.
+//                        //                  if `nil`, the element itself is used.
+//  iterateeY interface{} // ==> type: `func(each anyType)<number type>`
+//                        // ==> description: the function to get the second number of each element.
+//                        //                  if `nil`, the element itself is used.
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                         // ==> description: returns error object
-//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//  .Result() float64                  // ==> description: returns the correlation coefficient, between -1 and 1
+//  .ResultAndError() (float64, error) // ==> description: returns the correlation coefficient, between -1 and 1, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Exclude(itemToExclude interface{}) IChainable {
-	g.lastOperation = OperationExclude
+func (g *Chainable) Correlation(iterateeX, iterateeY interface{}) IChainableFloatResult {
+	g.lastOperation = OperationCorrelation
 	if g.IsError() || g.shouldReturn() {
-		return g
+		return &resultCorrelation{chainable: g}
 	}
 
 	err := (error)(nil)
-	result := _exclude(&err, g.data, itemToExclude)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		xs := _statsNumbers(err, g.data, []interface{}{iterateeX})
+		if *err != nil {
+			return nil
+		}
+
+		ys := _statsNumbers(err, g.data, []interface{}{iterateeY})
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.Correlation(xs, ys)
+		*err = statsErr
+		return result
+	}(&err)
 	if err != nil {
-		return g.markError(result, err)
+		return &resultCorrelation{chainable: g.markError(result, err)}
 	}
 
-	return g.markResult(result)
+	return &resultCorrelation{chainable: g.markResult(result)}
 }
 
-// ExcludeMany function removes all given values from `data`.
+// Count get the length of `data`.
 //
 // Parameters
 //
-// This function requires optional variadic parameters:
-//  itemToExclude1 interface{} // ==> description: the item to exclude
-//  itemToExclude2 interface{} // ==> description: the item to exclude
-//  itemToExclude3 interface{} // ==> description: the item to exclude
-//  ...
+// This function does not requires any parameter.
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                         // ==> description: returns error object
-//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//  .Result() int                  // ==> description: returns length of data
+//  .ResultAndError() (int, error) // ==> description: returns length of data, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) ExcludeMany(itemsToExclude ...interface{}) IChainable {
-	g.lastOperation = OperationExcludeMany
+func (g *Chainable) Count() IChainableNumberResult {
+	g.lastOperation = OperationCount
 	if g.IsError() || g.shouldReturn() {
-		return g
+		return &resultCount{chainable: g}
 	}
 
 	err := (error)(nil)
-	result := _exclude(&err, g.data, itemsToExclude...)
+	result := _count(&err, g.data, nil)
 	if err != nil {
-		return g.markError(result, err)
+		return &resultCount{chainable: g.markError(result, err)}
 	}
 
-	return g.markResult(result)
+	return &resultCount{chainable: g.markResult(result)}
 }
 
-func _exclude(err *error, data interface{}, items ...interface{}) interface{} {
-	defer catch(err)
-
-	if !isNonNilData(err, "data", data) {
-		return nil
-	}
-
-	dataValue, valueType, _, dataValueLen := inspectData(data)
-
-	if !isSlice(err, "data", dataValue) {
-		return nil
-	}
-
-	if len(items) == 0 {
-		return data
-	}
-
-	result := makeSlice(valueType)
-
-	if dataValueLen == 0 {
-		return result.Interface()
-	}
-
-	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		eachRealValue := each.Interface()
-		isFound := false
-
-		for _, item := range items {
-			if item == eachRealValue {
-				isFound = true
-			}
-		}
+// CountBy get the length of `data` filtered by `iteratee`.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  iteratee interface{} // ==> type: `func(each anyType, i int)bool` or
+//                       //           `func(value anyType, key anyType, i int)bool`
+//                       //     description: the function invoked per iteration
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() int                  // ==> description: returns the result after operation
+//  .ResultAndError() (int, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) CountBy(iteratee interface{}) IChainableNumberResult {
+	g.lastOperation = OperationCountBy
+	if g.IsError() || g.shouldReturn() {
+		return &resultCount{chainable: g}
+	}
 
-		if !isFound {
-			result = reflect.Append(result, each)
-		}
-	})
+	err := (error)(nil)
+	result := _count(&err, g.data, iteratee)
+	if err != nil {
+		return &resultCount{chainable: g.markError(result, err)}
+	}
 
-	return result.Interface()
+	return &resultCount{chainable: g.markResult(result)}
 }
 
-// ExcludeAt function removes value by index from `data`.
+// CountByOrdered function creates an ordered map composed of keys generated from the results of running each element of `data` thru iteratee. The corresponding value of each key is the number of elements responsible for generating the key. The keys are ordered by their first appearance.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  indexOfItemToExclude interface{} // ==> description: the index of item to exclude
+//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  the 2nd argument represents index of each element, and it's optional.
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Result() interface{}                  // ==> description: returns `*OrderedMap`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `*OrderedMap`, and error object
 //  .Error() error                         // ==> description: returns error object
 //  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) ExcludeAt(indexOfItemToExclude int) IChainable {
-	g.lastOperation = OperationExcludeAt
+func (g *Chainable) CountByOrdered(predicate interface{}) IChainable {
+	g.lastOperation = OperationCountByOrdered
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := _excludeAt(&err, g.data, indexOfItemToExclude)
+	result := _orderedBy(&err, g.data, predicate, orderedByCount)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -1167,15 +1119,15 @@ func (g *Chainable) ExcludeAt(indexOfItemToExclude int) IChainable {
 	return g.markResult(result)
 }
 
-// ExcludeAtMany function removes value by index from `data`.
+// CountByKey function creates a map composed of keys generated from the results of running each element of `data` thru iteratee. The corresponding value of each key is the number of elements responsible for generating the key, e.g. `map[K]int`.
+// Unlike `CountBy()` which returns the number of truthy predicate results, this function returns the count per key.
 //
 // Parameters
 //
-// This function requires optional variadic parameters:
-//  indexOfItemToExclude1 interface{} // ==> description: the index of item to exclude
-//  indexOfItemToExclude2 interface{} // ==> description: the index of item to exclude
-//  indexOfItemToExclude3 interface{} // ==> description: the index of item to exclude
-//  ...
+// This function requires single mandatory parameter:
+//  iteratee interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  the 2nd argument represents index of each element, and it's optional.
 //
 // Return values
 //
@@ -1188,14 +1140,23 @@ func (g *Chainable) ExcludeAt(indexOfItemToExclude int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) ExcludeAtMany(indexesOfItemToExclude ...int) IChainable {
-	g.lastOperation = OperationExcludeAtMany
+func (g *Chainable) CountByKey(iteratee interface{}) IChainable {
+	g.lastOperation = OperationCountByKey
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := _excludeAt(&err, g.data, indexesOfItemToExclude...)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		frequencies := _frequencies(err, g.data, iteratee)
+		if *err != nil {
+			return nil
+		}
+
+		return frequencies.toMap()
+	}(&err)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -1203,160 +1164,164 @@ func (g *Chainable) ExcludeAtMany(indexesOfItemToExclude ...int) IChainable {
 	return g.markResult(result)
 }
 
-func _excludeAt(err *error, data interface{}, indexes ...int) interface{} {
+func _count(err *error, data, predicate interface{}) int {
 	defer catch(err)
 
 	if !isNonNilData(err, "data", data) {
-		return nil
+		return 0
 	}
 
-	dataValue, dataType, _, dataValueLen := inspectData(data)
+	dataValue, dataValueType, dataValueKind, dataValueLen := inspectData(data)
 
 	if !isSlice(err, "data", dataValue) {
-		return nil
+		if dataValueKind == reflect.Map {
+			*err = nil
+			return _countCollection(err, dataValue, dataValueType, dataValueKind, dataValueLen, predicate)
+		}
+
+		return 0
 	}
 
-	for _, index := range indexes {
-		if !isZeroOrPositiveNumber(err, "index", index) {
-			return data
-		}
+	return _countSlice(err, dataValue, dataValueType, dataValueKind, dataValueLen, predicate)
+}
+
+func _countSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}) int {
+
+	var callbackValue reflect.Value
+	var callbackType reflect.Type
+	var callbackTypeNumIn int
+
+	if callback == nil {
+		return dataValueLen
 	}
 
-	if len(indexes) == 0 {
-		return data
+	callbackValue, callbackType = inspectFunc(err, callback)
+	if *err != nil {
+		return 0
 	}
 
-	result := makeSlice(dataType)
+	callbackTypeNumIn = validateFuncInputForSliceLoop(err, callbackType, dataValue)
+	if *err != nil {
+		return 0
+	}
 
-	if dataValueLen == 0 {
-		return result.Interface()
+	validateFuncOutputOneVarBool(err, callbackType, true)
+	if *err != nil {
+		return 0
 	}
 
-	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		isFound := false
+	resultCounter := 0
 
-		for _, index := range indexes {
-			if index == i {
-				isFound = true
-			}
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+		if res[0].Bool() {
+			resultCounter++
 		}
+	})
 
-		if !isFound {
-			result = reflect.Append(result, each)
+	return resultCounter
+}
+
+func _countCollection(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}) int {
+
+	var callbackValue reflect.Value
+	var callbackType reflect.Type
+	var callbackTypeNumIn int
+
+	if callback == nil {
+		return dataValueLen
+	}
+
+	callbackValue, callbackType = inspectFunc(err, callback)
+	if *err != nil {
+		return 0
+	}
+
+	callbackTypeNumIn = validateFuncInputForCollectionLoop(err, callbackType, dataValue)
+	if *err != nil {
+		return 0
+	}
+
+	validateFuncOutputOneVarBool(err, callbackType, true)
+	if *err != nil {
+		return 0
+	}
+
+	resultCounter := 0
+
+	dataValueMapKeys := dataValue.MapKeys()
+	forEachCollection(dataValue, dataValueMapKeys, func(value, key reflect.Value, i int) {
+		res := callFuncCollectionLoop(callbackValue, value, key, callbackTypeNumIn)
+		if res[0].Bool() {
+			resultCounter++
 		}
 	})
 
-	return result.Interface()
+	return resultCounter
 }
 
-// Fill function fills elements of `data` with `value` from `start` up to, but not including, `end`.
+// Covariance function computes the population covariance between two numbers taken from each element of `data`. Use `Covariance()` package function to compute it between two numeric slices.
 //
 // Parameters
 //
-// This function requires single mandatory parameter, `value`; and two other optional parameters:
-//  value interface{} // ==> description: the value to fill slice with. This variable's data type must be same with slice's element data type
-//  start int         // ==> optional
-//                    //     description: the start position
-//                    //     default value: 0
-//  end int           // ==> optional
-//                    //     description: the end position
-//                    //     default value: len(data)
+// This function requires two mandatory parameters:
+//  iterateeX interface{} // ==> type: `func(each anyType)<number type>`
+//                        // ==> description: the function to get the first number of each element.
+//                        //                  if `nil`, the element itself is used.
+//  iterateeY interface{} // ==> type: `func(each anyType)<number type>`
+//                        // ==> description: the function to get the second number of each element.
+//                        //                  if `nil`, the element itself is used.
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                         // ==> description: returns error object
-//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//  .Result() float64                  // ==> description: returns the covariance
+//  .ResultAndError() (float64, error) // ==> description: returns the covariance, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Fill(value interface{}, args ...int) IChainable {
-	g.lastOperation = OperationFill
+func (g *Chainable) Covariance(iterateeX, iterateeY interface{}) IChainableFloatResult {
+	g.lastOperation = OperationCovariance
 	if g.IsError() || g.shouldReturn() {
-		return g
+		return &resultCovariance{chainable: g}
 	}
 
 	err := (error)(nil)
 	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
-
-		startIndex := 0
-		lastIndex := dataValueLen
-
-		if len(args) > 0 {
-			startIndex = args[0]
-
-			if len(args) > 1 {
-				lastIndex = args[1]
-			}
-		}
-
-		if !isZeroOrPositiveNumber(err, "start index", startIndex) {
-			return g.data
-		}
-
-		if !isZeroOrPositiveNumber(err, "last index", lastIndex) {
-			return g.data
-		}
-
-		if !isLeftShouldBeGreaterOrEqualThanRight(err, "last index", lastIndex, "start index", startIndex) {
+		xs := _statsNumbers(err, g.data, []interface{}{iterateeX})
+		if *err != nil {
 			return nil
 		}
 
-		result := makeSlice(dataType)
-
-		if dataValueLen == 0 {
-			return result.Interface()
-		}
-
-		fillValue, fillType, _, _ := inspectData(value)
-
-		if fillType.Kind() != dataType.Elem().Kind() {
-			*err = errors.New("replacement data type must be same with slice's element type")
+		ys := _statsNumbers(err, g.data, []interface{}{iterateeY})
+		if *err != nil {
 			return nil
 		}
 
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			if i >= startIndex && i < lastIndex {
-				result = reflect.Append(result, fillValue)
-			} else {
-				result = reflect.Append(result, each)
-			}
-		})
-
-		return result.Interface()
+		result, statsErr := stats.Covariance(xs, ys)
+		*err = statsErr
+		return result
 	}(&err)
 	if err != nil {
-		return g.markError(result, err)
+		return &resultCovariance{chainable: g.markError(result, err)}
 	}
 
-	return g.markResult(result)
+	return &resultCovariance{chainable: g.markResult(result)}
 }
 
-// Filter function iterates over elements of slice or struct object or map, returning an array of all elements predicate returns truthy for.
+// CumulativeSum function computes the running total of numeric `data`. The result is `[]float64` with the same length as `data`, the sum is computed using the same method as `Sum()`.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
-//                        //           `func(value anyType, key anyType, i int)bool`
-//                        // ==> description: the function invoked per iteration.
-//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                        //                  and both are optional.
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
 //
 // Return values
 //
@@ -1369,8 +1334,8 @@ func (g *Chainable) Fill(value interface{}, args ...int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Filter(predicate interface{}) IChainable {
-	g.lastOperation = OperationFilter
+func (g *Chainable) CumulativeSum(args ...interface{}) IChainable {
+	g.lastOperation = OperationCumulativeSum
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -1379,22 +1344,49 @@ func (g *Chainable) Filter(predicate interface{}) IChainable {
 	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
+		return _cumulativeSum(err, g.data, args)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
 
-		dataValue, dataType, dataValueKind, dataValueLen := inspectData(g.data)
+	return g.markResult(result)
+}
 
-		if !isSlice(err, "data", dataValue) {
-			if dataValueKind == reflect.Map {
-				*err = nil
-				return _filterCollection(err, dataValue, dataType, dataValueKind, dataValueLen, predicate)
-			}
+// Diff function compares `data` with `dataToCompare`, the newer version of it, and finds the changed elements. The elements are matched by their key,
+// which should be comparable and unique. The result is `DiffResult` with the added, removed, moved and modified elements, and positional edit script.
+// The edit script is computed using the longest common subsequence of keys, it takes O(n log n) time and O(n) space.
+//
+// Parameters
+//
+// This function requires two mandatory parameters:
+//  dataToCompare interface{} // ==> description: the newer version of `data`, its element type should be same with `data`.
+//  keyFn interface{}         // ==> type: `func(each anyType)<any type>`
+//                            // ==> description: the function to get the key of each element.
+//                            //                  if `nil`, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `DiffResult`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `DiffResult`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Diff(dataToCompare, keyFn interface{}) IChainable {
+	g.lastOperation = OperationDiff
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
 
-			return nil
-		}
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-		return _filterSlice(err, dataValue, dataType, dataValueKind, dataValueLen, predicate)
+		return _diff(err, g.data, dataToCompare, keyFn)
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -1403,85 +1395,142 @@ func (g *Chainable) Filter(predicate interface{}) IChainable {
 	return g.markResult(result)
 }
 
-func _filterSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}) interface{} {
-	callbackValue, callbackType := inspectFunc(err, callback)
-	if *err != nil {
-		return nil
+// Difference function creates a slice of `data` that values not included in the other given slice. The order and references of result values are determined by the first slice.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  dataToCompare interface{} // ==> description: the slice to differentiate
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Difference(dataToCompare interface{}) IChainable {
+	g.lastOperation = OperationDifferenceMany
+	if g.IsError() || g.shouldReturn() {
+		return g
 	}
 
-	callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-	if *err != nil {
-		return nil
+	err := (error)(nil)
+	result := _difference(&err, g.data, dataToCompare)
+	if err != nil {
+		return g.markError(result, err)
 	}
 
-	validateFuncOutputOneVarBool(err, callbackType, true)
-	if *err != nil {
-		return nil
-	}
+	return g.markResult(result)
+}
 
-	result := makeSlice(dataValueType)
+// DifferenceMany function creates a slice of `data` that values not included in the other given slices. The order and references of result values are determined by the first slice.
+//
+// Parameters
+//
+// This function requires optional variadic parameters:
+//  datasToCompare1 interface{} // ==> description: the slice to differentiate
+//  datasToCompare2 interface{} // ==> description: the slice to differentiate
+//  datasToCompare3 interface{} // ==> description: the slice to differentiate
+//  ...
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) DifferenceMany(datasToCompare ...interface{}) IChainable {
+	g.lastOperation = OperationDifferenceMany
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
 
-	if dataValueLen == 0 {
-		return result.Interface()
+	if len(datasToCompare) == 0 {
+		return g.markError(nil, errors.New("data to compare cannot be empty"))
 	}
 
-	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-		if res[0].Bool() {
-			result = reflect.Append(result, each)
-		}
-	})
+	err := (error)(nil)
+	result := _difference(&err, g.data, datasToCompare...)
+	if err != nil {
+		return g.markError(result, err)
+	}
 
-	return result.Interface()
+	return g.markResult(result)
 }
 
-func _filterCollection(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}) interface{} {
-	callbackValue, callbackType := inspectFunc(err, callback)
-	if *err != nil {
-		return nil
-	}
+func _difference(err *error, data interface{}, dataToCompare ...interface{}) interface{} {
+	defer catch(err)
 
-	callbackTypeNumIn := validateFuncInputForCollectionLoop(err, callbackType, dataValue)
-	if *err != nil {
+	if !isNonNilData(err, "data", data) {
 		return nil
 	}
 
-	validateFuncOutputOneVarBool(err, callbackType, true)
-	if *err != nil {
+	dataValue, dataType, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
 		return nil
 	}
 
-	result := reflect.MakeMap(reflect.MapOf(dataValueType.Key(), dataValueType.Elem()))
+	result := makeSlice(dataType)
 
 	if dataValueLen == 0 {
 		return result.Interface()
 	}
 
-	dataValueMapKeys := dataValue.MapKeys()
-	forEachCollection(dataValue, dataValueMapKeys, func(value reflect.Value, key reflect.Value, i int) {
-		res := callFuncCollectionLoop(callbackValue, value, key, callbackTypeNumIn)
-		if res[0].Bool() {
-			result.SetMapIndex(key, value)
+	dataToCompareMap := make(map[reflect.Value]int)
+	for i, each := range dataToCompare {
+		eachLabel := fmt.Sprintf("difference data %d", (i + 1))
+		eachValue, eachType, _, eachValueLen := inspectData(each)
+
+		if !isSlice(err, eachLabel, eachValue) {
+			return nil
+		}
+
+		if !isTypeEqual(err, "data", dataType, eachLabel, eachType) {
+			return nil
+		}
+
+		dataToCompareMap[eachValue] = eachValueLen
+	}
+
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		isFound := false
+
+		for compareValue, compareValueLen := range dataToCompareMap {
+			forEachSliceStoppable(compareValue, compareValueLen, func(inner reflect.Value, j int) bool {
+				if each.Interface() == inner.Interface() {
+					isFound = true
+					return false
+				}
+
+				return true
+			})
+		}
+
+		if !isFound {
+			result = reflect.Append(result, each)
 		}
 	})
 
 	return result.Interface()
 }
 
-// Find function iterates over elements of collection, returning the first element predicate returns truthy for.
+// Drop function creates a slice of `data` with `n` elements dropped from the beginning.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
-//                        //           `func(value anyType, key anyType, i int)bool`
-//                        // ==> description: the function invoked per iteration.
-//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                        //                  and both are optional.
-//  fromIndex int         // ==> optional
-//                        //     description: The index to search from
-//                        //     default value: 0
+//  size int // ==> description: the number of elements to drop
 //
 // Return values
 //
@@ -1494,14 +1543,13 @@ func _filterCollection(err *error, dataValue reflect.Value, dataValueType reflec
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Find(predicate interface{}, args ...int) IChainable {
-	g.lastOperation = OperationFind
+func (g *Chainable) Drop(size int) IChainable {
+	g.lastOperation = OperationDrop
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-
 	result := func(err *error) interface{} {
 		defer catch(err)
 
@@ -1515,57 +1563,29 @@ func (g *Chainable) Find(predicate interface{}, args ...int) IChainable {
 			return nil
 		}
 
-		callbackValue, callbackType := inspectFunc(err, predicate)
-		if *err != nil {
-			return nil
-		}
-
-		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-		if *err != nil {
-			return nil
-		}
-
-		validateFuncOutputOneVarBool(err, callbackType, true)
-		if *err != nil {
-			return nil
+		if !isZeroOrPositiveNumber(err, "size", size) {
+			return g.data
 		}
 
-		fromIndex := 0
-		if len(args) > 0 {
-			fromIndex = args[0]
+		if size == 0 {
+			return g.data
 		}
 
-		if !isZeroOrPositiveNumber(err, "from index", fromIndex) {
-			return nil
-		}
+		result := makeSlice(dataType)
 
 		if dataValueLen == 0 {
-			return nil
+			return result.Interface()
 		}
 
-		isFound := false
-		result := reflect.New(dataType)
-
-		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-			if i < fromIndex {
-				return true
-			}
-
-			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-			if res[0].Bool() {
-				isFound = true
-				result = each
-				return false
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			if i < size {
+				return
 			}
 
-			return true
+			result = reflect.Append(result, each)
 		})
 
-		if isFound {
-			return result.Interface()
-		}
-
-		return nil
+		return result.Interface()
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -1574,20 +1594,12 @@ func (g *Chainable) Find(predicate interface{}, args ...int) IChainable {
 	return g.markResult(result)
 }
 
-// FindIndex function iterates over elements of collection, returning the index of first element predicate returns truthy for.
+// DropRight function creates a slice of `data` with `n` elements dropped from the end.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
-//                        //           `func(value anyType, key anyType, i int)bool`
-//                        // ==> description: the function invoked per iteration.
-//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                        //                  and both are optional.
-//  fromIndex int         // ==> optional
-//                        //     description: The index to search from
-//                        //     default value: 0
+//  size int // ==> description: the number of elements to drop
 //
 // Return values
 //
@@ -1600,67 +1612,47 @@ func (g *Chainable) Find(predicate interface{}, args ...int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) FindIndex(predicate interface{}, args ...int) IChainable {
-	g.lastOperation = OperationFindIndex
+func (g *Chainable) DropRight(size int) IChainable {
+	g.lastOperation = OperationDropRight
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) int {
+	result := func(err *error) interface{} {
 		defer catch(err)
 
 		if !isNonNilData(err, "data", g.data) {
-			return -1
+			return nil
 		}
 
-		dataValue, _, _, dataValueLen := inspectData(g.data)
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
-			return -1
+			return nil
 		}
 
-		callbackValue, callbackType := inspectFunc(err, predicate)
-		if *err != nil {
-			return -1
+		if !isZeroOrPositiveNumber(err, "size", size) {
+			return g.data
 		}
 
-		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-		if *err != nil {
-			return -1
+		if size == 0 {
+			return g.data
 		}
 
-		validateFuncOutputOneVarBool(err, callbackType, true)
-		if *err != nil {
-			return -1
-		}
+		result := makeSlice(dataType)
 
 		if dataValueLen == 0 {
-			return -1
-		}
-
-		startIndex := 0
-		if len(args) > 0 {
-			startIndex = args[0]
+			return result.Interface()
 		}
 
-		result := -1
-
-		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-			if i < startIndex {
-				return true
-			}
-
-			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-			if res[0].Bool() {
-				result = i
-				return false
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			if i < (dataValueLen - size) {
+				result = reflect.Append(result, each)
 			}
-
-			return true
 		})
 
-		return result
+		return result.Interface()
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -1669,20 +1661,15 @@ func (g *Chainable) FindIndex(predicate interface{}, args ...int) IChainable {
 	return g.markResult(result)
 }
 
-// FindLast function iterates over elements from tail to head, returning the first element predicate returns truthy for.
+// DropRightWhile function creates a slice of `data` excluding elements dropped from the end. Elements are dropped until `predicate` returns falsey, the iteration stops there.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
 //  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
-//                        //           `func(value anyType, key anyType, i int)bool`
+//                        //           `*Expression`, e.g. `Expr("Age >= 18")`
 //                        // ==> description: the function invoked per iteration.
-//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                        //                  and both are optional.
-//  fromIndex int         // ==> optional
-//                        //     description: The index to search from
-//                        //     default value: len(data)-1
+//                        //                  the 2nd argument represents index of each element, and it's optional.
 //
 // Return values
 //
@@ -1695,8 +1682,8 @@ func (g *Chainable) FindIndex(predicate interface{}, args ...int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) FindLast(predicate interface{}, args ...int) IChainable {
-	g.lastOperation = OperationFindLast
+func (g *Chainable) DropRightWhile(predicate interface{}) IChainable {
+	g.lastOperation = OperationDropRightWhile
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -1705,69 +1692,7 @@ func (g *Chainable) FindLast(predicate interface{}, args ...int) IChainable {
 	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
-
-		callbackValue, callbackType := inspectFunc(err, predicate)
-		if *err != nil {
-			return nil
-		}
-
-		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-		if *err != nil {
-			return nil
-		}
-
-		validateFuncOutputOneVarBool(err, callbackType, true)
-		if *err != nil {
-			return nil
-		}
-
-		lastIndex := dataValueLen - 1
-		if len(args) > 0 {
-			lastIndex = args[0]
-		}
-
-		if !isZeroOrPositiveNumber(err, "last index", lastIndex) {
-			return nil
-		}
-
-		if dataValueLen == 0 {
-			return nil
-		}
-
-		isFound := false
-		result := reflect.New(dataType)
-
-		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-			reverseIndex := dataValueLen - 1 - i
-			if reverseIndex > lastIndex {
-				return true
-			}
-
-			eachReverse := dataValue.Index(reverseIndex)
-			res := callFuncSliceLoop(callbackValue, dataValue.Index(reverseIndex), reverseIndex, callbackTypeNumIn)
-			if res[0].Bool() {
-				isFound = true
-				result = eachReverse
-				return false
-			}
-
-			return true
-		})
-
-		if isFound {
-			return result.Interface()
-		}
-
-		return nil
+		return _sliceWhile(err, g.data, predicate, true, false)
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -1776,20 +1701,15 @@ func (g *Chainable) FindLast(predicate interface{}, args ...int) IChainable {
 	return g.markResult(result)
 }
 
-// FindLastIndex function iterates over elements from tail to head, returning the index of first element predicate returns truthy for.
+// DropWhile function creates a slice of `data` excluding elements dropped from the beginning. Elements are dropped until `predicate` returns falsey, the iteration stops there.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
 //  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
-//                        //           `func(value anyType, key anyType, i int)bool`
+//                        //           `*Expression`, e.g. `Expr("Age >= 18")`
 //                        // ==> description: the function invoked per iteration.
-//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                        //                  and both are optional.
-//  fromIndex int         // ==> optional
-//                        //     description: The index to search from
-//                        //     default value: len(data)-1
+//                        //                  the 2nd argument represents index of each element, and it's optional.
 //
 // Return values
 //
@@ -1802,219 +1722,271 @@ func (g *Chainable) FindLast(predicate interface{}, args ...int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) FindLastIndex(predicate interface{}, args ...int) IChainable {
-	g.lastOperation = OperationFindLastIndex
+func (g *Chainable) DropWhile(predicate interface{}) IChainable {
+	g.lastOperation = OperationDropWhile
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) int {
+	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
-			return -1
-		}
-
-		dataValue, _, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return -1
-		}
+		return _sliceWhile(err, g.data, predicate, false, false)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
 
-		callbackValue, callbackType := inspectFunc(err, predicate)
-		if *err != nil {
-			return -1
-		}
+	return g.markResult(result)
+}
 
-		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-		if *err != nil {
-			return -1
-		}
+// _sliceWhile creates a slice of `data` with the elements taken (or dropped) while `predicate` returns truthy,
+// from the beginning or from the end. The iteration stops at the first element predicate returns falsey for.
+func _sliceWhile(err *error, data interface{}, predicate interface{}, fromRight bool, take bool) interface{} {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
 
-		validateFuncOutputOneVarBool(err, callbackType, true)
-		if *err != nil {
-			return -1
-		}
+	dataValue, dataType, _, dataValueLen := inspectData(data)
 
-		if dataValueLen == 0 {
-			return -1
-		}
+	predicate = bindExpression(err, predicate, dataValue, true)
+	if *err != nil {
+		return nil
+	}
 
-		endIndex := dataValueLen
-		if len(args) > 0 {
-			endIndex = args[0]
-		}
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
 
-		result := -1
+	predicateValue, predicateType := inspectFunc(err, predicate)
+	if *err != nil {
+		return nil
+	}
 
-		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-			if i > endIndex {
-				return true
-			}
+	predicateTypeNumIn := validateFuncInputForSliceLoop(err, predicateType, dataValue)
+	if *err != nil {
+		return nil
+	}
 
-			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-			if res[0].Bool() {
-				result = i
-			}
+	validateFuncOutputOneVarBool(err, predicateType, true)
+	if *err != nil {
+		return nil
+	}
 
-			return true
-		})
+	// elements before `boundary` are taken by TakeWhile, and elements after it by TakeRightWhile
+	boundary, step := 0, 1
+	if fromRight {
+		boundary, step = dataValueLen-1, -1
+	}
 
-		if result < 0 {
-			return result
+	for ; boundary >= 0 && boundary < dataValueLen; boundary += step {
+		res := callFuncSliceLoop(predicateValue, dataValue.Index(boundary), boundary, predicateTypeNumIn)
+		if !res[0].Bool() {
+			break
 		}
+	}
 
-		return result
-	}(&err)
-	if err != nil {
-		return g.markError(result, err)
+	if fromRight {
+		boundary++
 	}
 
-	return g.markResult(result)
+	lower, upper := 0, boundary
+	if take == fromRight {
+		lower, upper = boundary, dataValueLen
+	}
+
+	result := makeSlice(dataType)
+	result = reflect.AppendSlice(result, dataValue.Slice(lower, upper))
+
+	return result.Interface()
 }
 
-// First function gets the first element of `data`.
+// Each iterates over elements of `data` and invokes `iteratee` for each element. Iteratee functions may exit iteration early by explicitly returning false
 //
 // Parameters
 //
-// This function does not requires any parameter.
+// This function requires single mandatory parameter:
+//  iteratee interface{} // ==> type: `func(each anyType, i int)` or
+//                       //           `func(each anyType, i int)bool` or
+//                       //           `func(value anyType, key anyType, i int)` or
+//                       //           `func(value anyType, key anyType, i int)bool`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                       //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                       //                  and both are optional.
+//                       //                  if return value is provided then the next iteration is controlled by returned value.
+//                       //                  `return true` will make the iteration continue, meanwhile `return false` will stop it
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                         // ==> description: returns error object
-//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//  .Error() error  // ==> description: returns error object
+//  .IsError() bool // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) First() IChainable {
-	g.lastOperation = OperationFirst
+func (g *Chainable) Each(iteratee interface{}) IChainableNoReturnValueResult {
+	g.lastOperation = OperationEach
 	if g.IsError() || g.shouldReturn() {
-		return g
+		return &resultEach{chainable: g}
 	}
 
 	err := (error)(nil)
-	result := func(err *error) interface{} {
-		defer catch(err)
-
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, _, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
-
-		if dataValueLen == 0 {
-			return nil
-		}
-
-		return dataValue.Index(0).Interface()
-	}(&err)
+	_each(&err, g.data, iteratee, true)
 	if err != nil {
-		return g.markError(result, err)
+		return &resultEach{chainable: g.markError(nil, err)}
 	}
 
-	return g.markResult(result)
+	return &resultEach{chainable: g.markResult(nil)}
 }
 
-// FromPairs function returns an object composed from key-value `data`.
+// EachRight iterates over elements of `data` from tail to head, and invokes `iteratee` for each element. Iteratee functions may exit iteration early by explicitly returning false
 //
 // Parameters
 //
-// This function does not requires any parameter.
+// This function requires single mandatory parameter:
+//  iteratee interface{} // ==> type: `func(each anyType, i int)` or
+//                       //           `func(each anyType, i int)bool` or
+//                       //           `func(value anyType, key anyType, i int)` or
+//                       //           `func(value anyType, key anyType, i int)bool`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                       //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                       //                  and both are optional.
+//                       //                  if return value is provided then the next iteration is controlled by returned value.
+//                       //                  `return true` will make the iteration continue, meanwhile `return false` will stop it
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                         // ==> description: returns error object
-//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//  .Error() error  // ==> description: returns error object
+//  .IsError() bool // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) FromPairs() IChainable {
-	g.lastOperation = OperationFromPairs
+func (g *Chainable) EachRight(iteratee interface{}) IChainableNoReturnValueResult {
+	g.lastOperation = OperationEachRight
 	if g.IsError() || g.shouldReturn() {
-		return g
+		return &resultEach{chainable: g}
 	}
 
 	err := (error)(nil)
-	result := func(err *error) interface{} {
-		defer catch(err)
+	_each(&err, g.data, iteratee, true)
+	if err != nil {
+		return &resultEach{chainable: g.markError(nil, err)}
+	}
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
+	return &resultEach{chainable: g.markResult(nil)}
+}
 
-		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
+func _each(err *error, data, iteratee interface{}, isForward bool) {
+	defer catch(err)
 
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
+	if !isNonNilData(err, "data", data) {
+		return
+	}
 
-		if dataValueType.Elem().Kind() != reflect.Interface {
-			*err = errors.New("supported type only []interface{}")
-			return nil
+	dataValue, dataValueType, dataValueKind, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		if dataValueKind == reflect.Map {
+			*err = nil
+			_eachCollection(err, dataValue, dataValueType, dataValueKind, dataValueLen, iteratee, isForward)
 		}
 
-		result := make(map[interface{}]interface{}, 0)
+		return
+	}
 
-		if dataValueLen == 0 {
-			return result
-		}
+	_eachSlice(err, dataValue, dataValueType, dataValueKind, dataValueLen, iteratee, isForward)
+}
 
-		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-			if *err != nil {
-				result = nil
-				return false
-			}
+func _eachSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}, isLoopIncremental bool) {
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return
+	}
 
-			eachSlice := each.Elem()
-			eachSliceLen := eachSlice.Len()
+	callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+	if *err != nil {
+		return
+	}
 
-			if eachSliceLen > 2 {
-				eachSliceLen = 2
-			}
+	validateFuncOutputOneVarBool(err, callbackType, false)
+	if *err != nil {
+		return
+	}
 
-			if eachSliceLen > 0 {
-				eachSliceKey := eachSlice.Index(0).Interface()
-				result[eachSliceKey] = nil
+	if dataValueLen == 0 {
+		return
+	}
 
-				if eachSliceLen > 1 {
-					eachSliceVal := eachSlice.Index(1).Interface()
-					result[eachSliceKey] = eachSliceVal
-				}
-			}
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		var res []reflect.Value
+		if isLoopIncremental {
+			res = callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+		} else {
+			indexFromRight := dataValueLen - i - 1
+			eachFromRight := dataValue.Index(indexFromRight)
+			res = callFuncSliceLoop(callbackValue, eachFromRight, indexFromRight, callbackTypeNumIn)
+		}
 
-			return true
-		})
+		if len(res) > 0 {
+			return res[0].Bool()
+		}
 
-		return result
-	}(&err)
-	if err != nil {
-		return g.markError(result, err)
+		return true
+	})
+}
+
+func _eachCollection(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}, isLoopIncremental bool) {
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return
 	}
 
-	return g.markResult(result)
+	callbackTypeNumIn := validateFuncInputForCollectionLoop(err, callbackType, dataValue)
+	if *err != nil {
+		return
+	}
+
+	validateFuncOutputNone(err, callbackType)
+	if *err != nil {
+		return
+	}
+
+	if dataValueLen == 0 {
+		return
+	}
+
+	dataValueMapKeys := dataValue.MapKeys()
+	forEachCollectionStoppable(dataValue, dataValueMapKeys, func(value reflect.Value, key reflect.Value, i int) bool {
+		var res []reflect.Value
+		if isLoopIncremental {
+			res = callFuncCollectionLoop(callbackValue, value, key, callbackTypeNumIn)
+		} else {
+			indexFromRight := dataValueLen - i - 1
+			keyFromRight := dataValueMapKeys[indexFromRight]
+			valueFromRight := dataValue.MapIndex(keyFromRight)
+			res = callFuncCollectionLoop(callbackValue, valueFromRight, keyFromRight, callbackTypeNumIn)
+		}
+
+		if len(res) > 0 {
+			return res[0].Bool()
+		}
+
+		return true
+	})
 }
 
-// GroupBy function creates an object composed of keys generated from the results of running each element of collection thru iteratee. The order of grouped values is determined by the order they occur in collection. The corresponding value of each key is an array of elements responsible for generating the key.
+// Exclude function removes value from `data`.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>`
-//                        // ==> description: the function invoked per iteration.
-//                        //                  the 2nd argument represents index of each element, and it's optional.
+//  itemToExclude interface{} // ==> description: the item to exclude
 //
 // Return values
 //
@@ -2027,65 +1999,14 @@ func (g *Chainable) FromPairs() IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) GroupBy(predicate interface{}) IChainable {
-	g.lastOperation = OperationGroupBy
+func (g *Chainable) Exclude(itemToExclude interface{}) IChainable {
+	g.lastOperation = OperationExclude
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) interface{} {
-		defer catch(err)
-
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
-
-		callbackValue, callbackType := inspectFunc(err, predicate)
-		if *err != nil {
-			return nil
-		}
-
-		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-		if *err != nil {
-			return nil
-		}
-
-		validateFuncOutputOneVarDynamic(err, callbackType)
-		if *err != nil {
-			return nil
-		}
-
-		result := reflect.MakeMap(reflect.MapOf(callbackType.Out(0), dataType))
-
-		if dataValueLen == 0 {
-			return result.Interface()
-		}
-
-		resultMap := make(map[interface{}]reflect.Value)
-
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-			resActualValue := res[0].Interface()
-
-			if _, ok := resultMap[resActualValue]; !ok {
-				resultMap[resActualValue] = makeSlice(dataType)
-			}
-
-			mapValues := resultMap[resActualValue]
-			mapValues = reflect.Append(mapValues, each)
-			resultMap[resActualValue] = mapValues
-			result.SetMapIndex(res[0], mapValues)
-		})
-
-		return result.Interface()
-	}(&err)
+	result := _exclude(&err, g.data, itemToExclude)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -2093,154 +2014,89 @@ func (g *Chainable) GroupBy(predicate interface{}) IChainable {
 	return g.markResult(result)
 }
 
-// IndexOf function gets the index at which the first occurrence of `search` is found in `data`. If `fromIndex` is negative, it's used as the offset from the end of `data`.
+// ExcludeMany function removes all given values from `data`.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  search interface{} // ==> description: the value to search for.
-//  fromIndex int      // ==> optional
-//                     //     description: The index to search from
-//                     //     default value: 0
+// This function requires optional variadic parameters:
+//  itemToExclude1 interface{} // ==> description: the item to exclude
+//  itemToExclude2 interface{} // ==> description: the item to exclude
+//  itemToExclude3 interface{} // ==> description: the item to exclude
+//  ...
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() int                  // ==> description: return the index of found element
-//  .ResultAndError() (int, error) // ==> description: return the index of found element, and error object
-//  .Error() error                 // ==> description: returns error object
-//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) IndexOf(search interface{}, args ...int) IChainableNumberResult {
-	g.lastOperation = OperationIndexOf
+func (g *Chainable) ExcludeMany(itemsToExclude ...interface{}) IChainable {
+	g.lastOperation = OperationExcludeMany
 	if g.IsError() || g.shouldReturn() {
-		return &resultIndexOf{chainable: g}
+		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) int {
-		defer catch(err)
+	result := _exclude(&err, g.data, itemsToExclude...)
+	if err != nil {
+		return g.markError(result, err)
+	}
 
-		if !isNonNilData(err, "data", g.data) {
-			return -1
-		}
+	return g.markResult(result)
+}
 
-		dataValue, _, _, dataValueLen := inspectData(g.data)
+func _exclude(err *error, data interface{}, items ...interface{}) interface{} {
+	defer catch(err)
 
-		if !isSlice(err, "data", dataValue) {
-			return -1
-		}
-
-		startIndex := 0
-		if len(args) > 0 {
-			startIndex = args[0]
-		}
-
-		if startIndex >= dataValueLen {
-			return -1
-		}
-
-		if dataValueLen == 0 {
-			return -1
-		}
-
-		result := -1
-
-		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-			if startIndex > -1 {
-				if startIndex > 0 && i < startIndex {
-					return true
-				}
-
-				if each.Interface() == search && result == -1 {
-					result = i
-					return false
-				}
-			} else {
-				if i > (startIndex*-1)-1 {
-					return true
-				}
-
-				iFromRight := dataValueLen - i - 1
-				eachFromRight := dataValue.Index(iFromRight)
-
-				if eachFromRight.Interface() == search {
-					result = iFromRight
-					return true
-				}
-			}
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
 
-			return true
-		})
+	dataValue, valueType, _, dataValueLen := inspectData(data)
 
-		return result
-	}(&err)
-	if err != nil {
-		return &resultIndexOf{chainable: g.markError(result, err)}
+	if !isSlice(err, "data", dataValue) {
+		return nil
 	}
 
-	return &resultIndexOf{chainable: g.markResult(result)}
-}
-
-// Initial function gets all but the last element of `data`.
-//
-// Parameters
-//
-// This function does not requires any parameter.
-//
-// Return values
-//
-// Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                         // ==> description: returns error object
-//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
-//
-// Examples
-//
-// List of examples available:
-func (g *Chainable) Initial() IChainable {
-	g.lastOperation = OperationInitial
-	if g.IsError() || g.shouldReturn() {
-		return g
+	if len(items) == 0 {
+		return data
 	}
 
-	err := (error)(nil)
-	result := func(err *error) interface{} {
-		defer catch(err)
+	result := makeSlice(valueType)
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
+	if dataValueLen == 0 {
+		return result.Interface()
+	}
 
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		eachRealValue := each.Interface()
+		isFound := false
 
-		if !isSlice(err, "data", dataValue) {
-			return nil
+		for _, item := range items {
+			if item == eachRealValue {
+				isFound = true
+			}
 		}
 
-		if dataValueLen == 0 {
-			return makeSlice(dataType).Interface()
+		if !isFound {
+			result = reflect.Append(result, each)
 		}
+	})
 
-		return dataValue.Slice(0, dataValueLen-1).Interface()
-	}(&err)
-	if err != nil {
-		return g.markError(result, err)
-	}
-
-	return g.markResult(result)
+	return result.Interface()
 }
 
-// Intersection function creates a slice of unique values that are included in all given slice. The order and references of result values are determined by the first slice.
+// ExcludeAt function removes value by index from `data`.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  dataToIntersect interface{} // ==> description: the slice to intersect
+//  indexOfItemToExclude interface{} // ==> description: the index of item to exclude
 //
 // Return values
 //
@@ -2253,14 +2109,14 @@ func (g *Chainable) Initial() IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Intersection(dataIntersect interface{}) IChainable {
-	g.lastOperation = OperationIntersection
+func (g *Chainable) ExcludeAt(indexOfItemToExclude int) IChainable {
+	g.lastOperation = OperationExcludeAt
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := _intersection(&err, g.data, dataIntersect)
+	result := _excludeAt(&err, g.data, indexOfItemToExclude)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -2268,14 +2124,14 @@ func (g *Chainable) Intersection(dataIntersect interface{}) IChainable {
 	return g.markResult(result)
 }
 
-// IntersectionMany function creates a slice of unique values that are included in all given slices. The order and references of result values are determined by the first slice.
+// ExcludeAtMany function removes value by index from `data`.
 //
 // Parameters
 //
 // This function requires optional variadic parameters:
-//  dataToIntersect1 interface{} // ==> description: the slice to intersect
-//  dataToIntersect2 interface{} // ==> description: the slice to intersect
-//  dataToIntersect3 interface{} // ==> description: the slice to intersect
+//  indexOfItemToExclude1 interface{} // ==> description: the index of item to exclude
+//  indexOfItemToExclude2 interface{} // ==> description: the index of item to exclude
+//  indexOfItemToExclude3 interface{} // ==> description: the index of item to exclude
 //  ...
 //
 // Return values
@@ -2289,18 +2145,14 @@ func (g *Chainable) Intersection(dataIntersect interface{}) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) IntersectionMany(dataToIntersects ...interface{}) IChainable {
-	g.lastOperation = OperationIntersection
+func (g *Chainable) ExcludeAtMany(indexesOfItemToExclude ...int) IChainable {
+	g.lastOperation = OperationExcludeAtMany
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
-	if len(dataToIntersects) == 0 {
-		return g.markError(nil, errors.New("data intersects cannot be nil"))
-	}
-
 	err := (error)(nil)
-	result := _intersection(&err, g.data, dataToIntersects...)
+	result := _excludeAt(&err, g.data, indexesOfItemToExclude...)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -2308,7 +2160,7 @@ func (g *Chainable) IntersectionMany(dataToIntersects ...interface{}) IChainable
 	return g.markResult(result)
 }
 
-func _intersection(err *error, data interface{}, dataIntersects ...interface{}) interface{} {
+func _excludeAt(err *error, data interface{}, indexes ...int) interface{} {
 	defer catch(err)
 
 	if !isNonNilData(err, "data", data) {
@@ -2321,24 +2173,14 @@ func _intersection(err *error, data interface{}, dataIntersects ...interface{})
 		return nil
 	}
 
-	type CompareMap struct {
-		Value reflect.Value
-		Len   int
+	for _, index := range indexes {
+		if !isZeroOrPositiveNumber(err, "index", index) {
+			return data
+		}
 	}
-	compareValueInReflect := make([]CompareMap, 0)
-
-	for _, compare := range dataIntersects {
-		eachValue, _, _, eachValueLen := inspectData(compare)
 
-		if isSlice(err, "data", eachValue) {
-			compareValueInReflect = append(compareValueInReflect, CompareMap{
-				Value: eachValue,
-				Len:   eachValueLen,
-			})
-		} else {
-			*err = errors.New("All data should be slice")
-			return nil
-		}
+	if len(indexes) == 0 {
+		return data
 	}
 
 	result := makeSlice(dataType)
@@ -2347,133 +2189,130 @@ func _intersection(err *error, data interface{}, dataIntersects ...interface{})
 		return result.Interface()
 	}
 
-	resultMap := make(map[interface{}]bool)
-
 	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		eachActualValue := each.Interface()
-
-		isValueExists := true
-		isJustStarted := true
-
-		for _, eachCompare := range compareValueInReflect {
-			isInnerExists := false
-
-			forEachSliceStoppable(eachCompare.Value, eachCompare.Len, func(inner reflect.Value, j int) bool {
-				if eachActualValue == inner.Interface() {
-					isInnerExists = true
-					return false
-				}
-
-				return true
-			})
+		isFound := false
 
-			if isJustStarted {
-				isValueExists = isInnerExists
-			} else {
-				isValueExists = isValueExists && isInnerExists
+		for _, index := range indexes {
+			if index == i {
+				isFound = true
 			}
 		}
 
-		if isValueExists {
-			if _, ok := resultMap[eachActualValue]; !ok {
-				resultMap[eachActualValue] = true
-				result = reflect.Append(result, each)
-			}
+		if !isFound {
+			result = reflect.Append(result, each)
 		}
 	})
 
 	return result.Interface()
 }
 
-// Join function converts all elements in `data` into a string separated by `separator`.
+// Fill function fills elements of `data` with `value` from `start` up to, but not including, `end`.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  separator string // ==> description: the element joiner
+// This function requires single mandatory parameter, `value`; and two other optional parameters:
+//  value interface{} // ==> description: the value to fill slice with. This variable's data type must be same with slice's element data type
+//  start int         // ==> optional
+//                    //     description: the start position
+//                    //     default value: 0
+//  end int           // ==> optional
+//                    //     description: the end position
+//                    //     default value: len(data)
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() string                  // ==> description: returns the result after operation
-//  .ResultAndError() (string, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                    // ==> description: returns error object
-//  .IsError() bool                   // ==> description: return `true` on error, otherwise `false`
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Join(separator string) IChainableStringResult {
-	g.lastOperation = OperationJoin
+func (g *Chainable) Fill(value interface{}, args ...int) IChainable {
+	g.lastOperation = OperationFill
 	if g.IsError() || g.shouldReturn() {
-		return &resultJoin{chainable: g}
+		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) string {
+	result := func(err *error) interface{} {
 		defer catch(err)
 
 		if !isNonNilData(err, "data", g.data) {
-			return ""
+			return nil
 		}
 
-		dataValue, _, _, dataValueLen := inspectData(g.data)
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
-			return ""
+			return nil
 		}
 
-		if val, ok := g.data.([]string); ok {
-			return strings.Join(val, separator)
+		startIndex := 0
+		lastIndex := dataValueLen
+
+		if len(args) > 0 {
+			startIndex = args[0]
+
+			if len(args) > 1 {
+				lastIndex = args[1]
+			}
 		}
 
-		if dataValueLen == 0 {
-			return ""
+		if !isZeroOrPositiveNumber(err, "start index", startIndex) {
+			return g.data
 		}
 
-		dataInStringSlice := make([]string, 0)
+		if !isZeroOrPositiveNumber(err, "last index", lastIndex) {
+			return g.data
+		}
 
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		if !isLeftShouldBeGreaterOrEqualThanRight(err, "last index", lastIndex, "start index", startIndex) {
+			return nil
+		}
 
-			if each.Interface() != nil {
-				target := each
+		result := makeSlice(dataType)
 
-				if each.Kind() == reflect.Interface {
-					target = each.Elem()
-				}
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
 
-				if target.Kind() == reflect.String {
-					dataInStringSlice = append(dataInStringSlice, target.String())
-				} else {
-					dataInStringSlice = append(dataInStringSlice, fmt.Sprintf("%v", target.Interface()))
-				}
-			}
-		})
+		fillValue, fillType, _, _ := inspectData(value)
 
-		if len(dataInStringSlice) == 0 {
-			return ""
+		if fillType.Kind() != dataType.Elem().Kind() {
+			*err = errors.New("replacement data type must be same with slice's element type")
+			return nil
 		}
 
-		return strings.Join(dataInStringSlice, separator)
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			if i >= startIndex && i < lastIndex {
+				result = reflect.Append(result, fillValue)
+			} else {
+				result = reflect.Append(result, each)
+			}
+		})
+
+		return result.Interface()
 	}(&err)
 	if err != nil {
-		return &resultJoin{chainable: g.markError(result, err)}
+		return g.markError(result, err)
 	}
 
-	return &resultJoin{chainable: g.markResult(result)}
+	return g.markResult(result)
 }
 
-// KeyBy function creates an object composed of keys generated from the results of running each element of collection thru iteratee. The corresponding value of each key is the last element responsible for generating the key.
+// FillBackward function replaces each missing element of `data` using the nearest non-missing element after it. Missing elements at the end of `data` are left as they are.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>` or
-//                        //           `func(value anyType, key anyType, i int)<any type>`
-//                        // ==> description: the function invoked per iteration.
-//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                        //                  and both are optional.
+// This function requires one mandatory parameter, and one optional parameter:
+//  isMissing interface{} // ==> type: `func(each anyType)bool`
+//                        // ==> description: the function to decide whether element is missing
+//  filler interface{}    // ==> type: `func(missing anyType, source anyType)anyType`
+//                        // ==> description: the function to fill the missing element using the source element, e.g. to copy only the value but keep the time.
+//                        //                  if not provided, the missing element is replaced by the source element.
 //
 // Return values
 //
@@ -2486,8 +2325,8 @@ func (g *Chainable) Join(separator string) IChainableStringResult {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) KeyBy(predicate interface{}) IChainable {
-	g.lastOperation = OperationKeyBy
+func (g *Chainable) FillBackward(isMissing interface{}, args ...interface{}) IChainable {
+	g.lastOperation = OperationFillBackward
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -2496,44 +2335,7 @@ func (g *Chainable) KeyBy(predicate interface{}) IChainable {
 	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
-
-		callbackValue, callbackType := inspectFunc(err, predicate)
-		if *err != nil {
-			return nil
-		}
-
-		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-		if *err != nil {
-			return nil
-		}
-
-		validateFuncOutputOneVarDynamic(err, callbackType)
-		if *err != nil {
-			return nil
-		}
-
-		valueElemType := dataValueType.Elem()
-		result := reflect.MakeMap(reflect.MapOf(callbackType.Out(0), valueElemType))
-
-		if dataValueLen == 0 {
-			return result.Interface()
-		}
-
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-			result.SetMapIndex(res[0], each)
-		})
-
-		return result.Interface()
+		return _fill(err, g.data, isMissing, args, false)
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -2542,11 +2344,16 @@ func (g *Chainable) KeyBy(predicate interface{}) IChainable {
 	return g.markResult(result)
 }
 
-// Last function gets the last element of `data`.
+// FillForward function replaces each missing element of `data` using the nearest non-missing element before it. Missing elements at the beginning of `data` are left as they are.
 //
 // Parameters
 //
-// This function does not requires any parameter.
+// This function requires one mandatory parameter, and one optional parameter:
+//  isMissing interface{} // ==> type: `func(each anyType)bool`
+//                        // ==> description: the function to decide whether element is missing
+//  filler interface{}    // ==> type: `func(missing anyType, source anyType)anyType`
+//                        // ==> description: the function to fill the missing element using the source element, e.g. to copy only the value but keep the time.
+//                        //                  if not provided, the missing element is replaced by the source element.
 //
 // Return values
 //
@@ -2559,8 +2366,8 @@ func (g *Chainable) KeyBy(predicate interface{}) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Last() IChainable {
-	g.lastOperation = OperationLast
+func (g *Chainable) FillForward(isMissing interface{}, args ...interface{}) IChainable {
+	g.lastOperation = OperationFillForward
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -2569,21 +2376,7 @@ func (g *Chainable) Last() IChainable {
 	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, _, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
-
-		if dataValueLen == 0 {
-			return nil
-		}
-
-		return dataValue.Index(dataValueLen - 1).Interface()
+		return _fill(err, g.data, isMissing, args, true)
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -2592,106 +2385,149 @@ func (g *Chainable) Last() IChainable {
 	return g.markResult(result)
 }
 
-// LastIndexOf function iterates the element from tail to head, then return the index at which the first occurrence of `search` is found in `data`. If `fromIndex` is negative, it's used as the offset from the end of `data`.
+// Filter function iterates over elements of slice or struct object or map, returning an array of all elements predicate returns truthy for.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  search interface{} // ==> description: the value to search for.
-//  fromIndex int      // ==> optional
-//                     //     description: The index to search from
-//                     //     default value: len(data)-1
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
+//                        //           `func(value anyType, key anyType, i int)bool` or
+//                        //           `*Expression`, e.g. `Expr("Age >= 18")`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                        //                  and both are optional.
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() int                  // ==> description: return the index of found element
-//  .ResultAndError() (int, error) // ==> description: return the index of found element, and error object
-//  .Error() error                 // ==> description: returns error object
-//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) LastIndexOf(search interface{}, args ...int) IChainableNumberResult {
-	g.lastOperation = OperationLast
+func (g *Chainable) Filter(predicate interface{}) IChainable {
+	g.lastOperation = OperationFilter
 	if g.IsError() || g.shouldReturn() {
-		return &resultLastIndexOf{chainable: g}
+		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) int {
+	result := func(err *error) interface{} {
 		defer catch(err)
 
 		if !isNonNilData(err, "data", g.data) {
-			return -1
+			return nil
 		}
 
-		dataValue, _, _, dataValueLen := inspectData(g.data)
+		dataValue, dataType, dataValueKind, dataValueLen := inspectData(g.data)
 
-		if !isSlice(err, "data", dataValue) {
-			return -1
+		predicate := bindExpression(err, predicate, dataValue, true)
+		if *err != nil {
+			return nil
 		}
 
-		startIndex := dataValueLen - 1
-		if len(args) > 0 {
-			startIndex = args[0]
+		if !isSlice(err, "data", dataValue) {
+			if dataValueKind == reflect.Map {
+				*err = nil
+				return _filterCollection(err, dataValue, dataType, dataValueKind, dataValueLen, predicate)
+			}
+
+			return nil
 		}
 
-		if dataValueLen == 0 {
-			return -1
+		return _filterSlice(err, dataValue, dataType, dataValueKind, dataValueLen, predicate)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+func _filterSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}) interface{} {
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return nil
+	}
+
+	callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+	if *err != nil {
+		return nil
+	}
+
+	validateFuncOutputOneVarBool(err, callbackType, true)
+	if *err != nil {
+		return nil
+	}
+
+	result := makeSlice(dataValueType)
+
+	if dataValueLen == 0 {
+		return result.Interface()
+	}
+
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+		if res[0].Bool() {
+			result = reflect.Append(result, each)
 		}
+	})
 
-		result := -1
+	return result.Interface()
+}
 
-		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
-			if startIndex > -1 {
-				iFromRight := startIndex - i
-				if iFromRight > (dataValueLen-1) || iFromRight < 0 {
-					return true
-				}
+func _filterCollection(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback interface{}) interface{} {
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return nil
+	}
 
-				eachFromRight := dataValue.Index(iFromRight)
-				if eachFromRight.Interface() == search && result == -1 {
-					result = iFromRight
-					return true
-				}
-			} else {
-				iFromRight := dataValueLen + startIndex - i
-				if iFromRight > (dataValueLen-1) || iFromRight < 0 {
-					return true
-				}
+	callbackTypeNumIn := validateFuncInputForCollectionLoop(err, callbackType, dataValue)
+	if *err != nil {
+		return nil
+	}
 
-				eachFromRight := dataValue.Index(iFromRight)
-				if eachFromRight.Interface() == search && result == -1 {
-					result = iFromRight
-					return true
-				}
-			}
+	validateFuncOutputOneVarBool(err, callbackType, true)
+	if *err != nil {
+		return nil
+	}
 
-			return true
-		})
+	result := reflect.MakeMap(reflect.MapOf(dataValueType.Key(), dataValueType.Elem()))
 
-		return result
-	}(&err)
-	if err != nil {
-		return &resultLastIndexOf{chainable: g.markError(result, err)}
+	if dataValueLen == 0 {
+		return result.Interface()
 	}
 
-	return &resultLastIndexOf{chainable: g.markResult(result)}
+	dataValueMapKeys := dataValue.MapKeys()
+	forEachCollection(dataValue, dataValueMapKeys, func(value reflect.Value, key reflect.Value, i int) {
+		res := callFuncCollectionLoop(callbackValue, value, key, callbackTypeNumIn)
+		if res[0].Bool() {
+			result.SetMapIndex(key, value)
+		}
+	})
+
+	return result.Interface()
 }
 
-// Map function creates an array of values by running each element in `data` thru iteratee.
+// Find function iterates over elements of collection, returning the first element predicate returns truthy for.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  callback interface{} // ==> type: `func(each anyType, i int)<any type>` or
-//                       //           `func(value anyType, key anyType, i int)<any type>`
-//                       // ==> description: the function invoked per iteration.
-//                       //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                       //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                       //                  and both are optional.
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
+//                        //           `func(value anyType, key anyType, i int)bool` or
+//                        //           `*Expression`, e.g. `Expr("Age >= 18")`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                        //                  and both are optional.
+//  fromIndex int         // ==> optional
+//                        //     description: The index to search from
+//                        //     default value: 0
 //
 // Return values
 //
@@ -2704,13 +2540,14 @@ func (g *Chainable) LastIndexOf(search interface{}, args ...int) IChainableNumbe
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Map(callback interface{}) IChainable {
-	g.lastOperation = OperationMap
+func (g *Chainable) Find(predicate interface{}, args ...int) IChainable {
+	g.lastOperation = OperationFind
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
+
 	result := func(err *error) interface{} {
 		defer catch(err)
 
@@ -2718,13 +2555,18 @@ func (g *Chainable) Map(callback interface{}) IChainable {
 			return nil
 		}
 
-		dataValue, _, _, dataValueLen := inspectData(g.data)
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
 			return nil
 		}
 
-		callbackValue, callbackType := inspectFunc(err, callback)
+		predicate := bindExpression(err, predicate, dataValue, true)
+		if *err != nil {
+			return nil
+		}
+
+		callbackValue, callbackType := inspectFunc(err, predicate)
 		if *err != nil {
 			return nil
 		}
@@ -2734,23 +2576,47 @@ func (g *Chainable) Map(callback interface{}) IChainable {
 			return nil
 		}
 
-		validateFuncOutputOneVarDynamic(err, callbackType)
+		validateFuncOutputOneVarBool(err, callbackType, true)
 		if *err != nil {
 			return nil
 		}
 
-		result := makeSlice(reflect.SliceOf(callbackType.Out(0)))
+		fromIndex := 0
+		if len(args) > 0 {
+			fromIndex = args[0]
+		}
+
+		if !isZeroOrPositiveNumber(err, "from index", fromIndex) {
+			return nil
+		}
 
 		if dataValueLen == 0 {
-			return result.Interface()
+			return nil
 		}
 
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		isFound := false
+		result := reflect.New(dataType)
+
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			if i < fromIndex {
+				return true
+			}
+
 			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-			result = reflect.Append(result, res[0])
+			if res[0].Bool() {
+				isFound = true
+				result = each
+				return false
+			}
+
+			return true
 		})
 
-		return result.Interface()
+		if isFound {
+			return result.Interface()
+		}
+
+		return nil
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -2759,12 +2625,20 @@ func (g *Chainable) Map(callback interface{}) IChainable {
 	return g.markResult(result)
 }
 
-// Nth function gets the element at index `n` of `data`. If `n` is negative, the nth element from the end is returned.
+// FindIndex function iterates over elements of collection, returning the index of first element predicate returns truthy for.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  index int // ==> description: the index of the element to return
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
+//                        //           `func(value anyType, key anyType, i int)bool`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                        //                  and both are optional.
+//  fromIndex int         // ==> optional
+//                        //     description: The index to search from
+//                        //     default value: 0
 //
 // Return values
 //
@@ -2777,40 +2651,67 @@ func (g *Chainable) Map(callback interface{}) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Nth(index int) IChainable {
-	g.lastOperation = OperationNth
+func (g *Chainable) FindIndex(predicate interface{}, args ...int) IChainable {
+	g.lastOperation = OperationFindIndex
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-
-	result := func(err *error) interface{} {
+	result := func(err *error) int {
 		defer catch(err)
 
 		if !isNonNilData(err, "data", g.data) {
-			return nil
+			return -1
 		}
 
 		dataValue, _, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
-			return nil
+			return -1
 		}
 
-		if dataValueLen == 0 {
-			return nil
+		callbackValue, callbackType := inspectFunc(err, predicate)
+		if *err != nil {
+			return -1
 		}
 
-		if index < 0 {
-			index = dataValueLen + index
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return -1
 		}
 
-		if index < dataValueLen {
-			return dataValue.Index(index).Interface()
+		validateFuncOutputOneVarBool(err, callbackType, true)
+		if *err != nil {
+			return -1
 		}
 
-		return nil
+		if dataValueLen == 0 {
+			return -1
+		}
+
+		startIndex := 0
+		if len(args) > 0 {
+			startIndex = args[0]
+		}
+
+		result := -1
+
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			if i < startIndex {
+				return true
+			}
+
+			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+			if res[0].Bool() {
+				result = i
+				return false
+			}
+
+			return true
+		})
+
+		return result
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -2819,23 +2720,20 @@ func (g *Chainable) Nth(index int) IChainable {
 	return g.markResult(result)
 }
 
-// OrderBy sort slices. If orders is unspecified, all values are sorted in ascending order. Otherwise, specify an order of "desc" for descending or "asc" for ascending sort order of corresponding values. The algorithm used is merge sort, as per savigo's post on https://sagivo.com/go-sort-faster-4869bdabc670
+// FindLast function iterates over elements from tail to head, returning the first element predicate returns truthy for.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>` or
-//                        //           `func(value anyType, key anyType, i int)<any type>`
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
+//                        //           `func(value anyType, key anyType, i int)bool`
 //                        // ==> description: the function invoked per iteration.
 //                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
 //                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
 //                        //                  and both are optional.
-//  isAscending bool      // ==> optional
-//                        //     description: the sort order. `true` for ascending, and `false` for descending.
-//                        //     default value: true
-//  isAsync bool          // ==> optional
-//                        //     description: concurrent sort. set to `true` to enable pararel sorting (faster for certain data structure)
-//                        //     default value: false
+//  fromIndex int         // ==> optional
+//                        //     description: The index to search from
+//                        //     default value: len(data)-1
 //
 // Return values
 //
@@ -2848,346 +2746,5527 @@ func (g *Chainable) Nth(index int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) OrderBy(predicate interface{}, args ...bool) IChainable {
-	g.lastOperation = OperationOrderBy
+func (g *Chainable) FindLast(predicate interface{}, args ...int) IChainable {
+	g.lastOperation = OperationFindLast
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := _orderBy(&err, g.data, predicate, args...)
-	if err != nil {
-		return g.markError(result, err)
-	}
-
-	return g.markResult(result)
-}
-
-func _orderBy(err *error, data, callback interface{}, args ...bool) interface{} {
-	defer catch(err)
-
-	if !isNonNilData(err, "data", data) {
-		return nil
-	}
-
-	dataValue, dataValueType, _, _ := inspectData(data)
-
-	if !isSlice(err, "data", dataValue) {
-		return nil
-	}
-
-	callbackValue, callbackType := inspectFunc(err, callback)
-	if *err != nil {
-		return nil
-	}
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-	validateFuncInputForSliceLoopWithoutIndex(err, callbackType, dataValue)
-	if *err != nil {
-		return nil
-	}
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
 
-	validateFuncOutputOneVarDynamic(err, callbackType)
-	if *err != nil {
-		return nil
-	}
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
 
-	isAscending := true
-	if len(args) > 0 {
-		isAscending = args[0]
-	}
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
 
-	isAsync := false
-	if len(args) > 1 {
-		isAsync = args[1]
-	}
+		callbackValue, callbackType := inspectFunc(err, predicate)
+		if *err != nil {
+			return nil
+		}
 
-	// =====
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
+		}
 
-	var _doSortAsync func(reflect.Value, chan reflect.Value)
-	var _doSortSync func(reflect.Value) reflect.Value
-	var _doMerge func(reflect.Value, reflect.Value) reflect.Value
+		validateFuncOutputOneVarBool(err, callbackType, true)
+		if *err != nil {
+			return nil
+		}
 
-	_doSortAsync = func(slice reflect.Value, c chan reflect.Value) {
-		sliceLen := slice.Len()
+		lastIndex := dataValueLen - 1
+		if len(args) > 0 {
+			lastIndex = args[0]
+		}
 
-		if sliceLen < -1 {
-			c <- _doSortSync(slice)
-			return
+		if !isZeroOrPositiveNumber(err, "last index", lastIndex) {
+			return nil
 		}
 
-		if sliceLen < 2 {
-			c <- slice
-			return
+		if dataValueLen == 0 {
+			return nil
 		}
 
-		mid := sliceLen / 2
+		isFound := false
+		result := reflect.New(dataType)
 
-		c1 := make(chan reflect.Value, 1)
-		c2 := make(chan reflect.Value, 1)
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			reverseIndex := dataValueLen - 1 - i
+			if reverseIndex > lastIndex {
+				return true
+			}
 
-		go _doSortAsync(slice.Slice(0, mid), c1)
-		go _doSortAsync(slice.Slice(mid, sliceLen), c2)
+			eachReverse := dataValue.Index(reverseIndex)
+			res := callFuncSliceLoop(callbackValue, dataValue.Index(reverseIndex), reverseIndex, callbackTypeNumIn)
+			if res[0].Bool() {
+				isFound = true
+				result = eachReverse
+				return false
+			}
 
-		go func() {
-			c <- _doMerge(<-c1, <-c2)
-		}()
-	}
+			return true
+		})
 
-	_doSortSync = func(slice reflect.Value) reflect.Value {
-		sliceLen := slice.Len()
-		if sliceLen < 2 {
-			return slice
+		if isFound {
+			return result.Interface()
 		}
 
-		mid := sliceLen / 2
-		leftSlice := _doSortSync(slice.Slice(0, mid))
-		rightSlice := _doSortSync(slice.Slice(mid, slice.Len()))
-
-		return _doMerge(leftSlice, rightSlice)
+		return nil
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
 	}
 
-	_doMerge = func(leftSlice, rightSlice reflect.Value) reflect.Value {
-		bitSize := 64
-		base := 10
+	return g.markResult(result)
+}
 
-		resultLen := leftSlice.Len() + rightSlice.Len()
-		result := makeSlice(dataValueType, resultLen, resultLen)
+// FindLastIndex function iterates over elements from tail to head, returning the index of first element predicate returns truthy for.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
+//                        //           `func(value anyType, key anyType, i int)bool`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                        //                  and both are optional.
+//  fromIndex int         // ==> optional
+//                        //     description: The index to search from
+//                        //     default value: len(data)-1
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) FindLastIndex(predicate interface{}, args ...int) IChainable {
+	g.lastOperation = OperationFindLastIndex
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
 
-		isSortable := true
+	err := (error)(nil)
+	result := func(err *error) int {
+		defer catch(err)
 
-		var i, j int
+		if !isNonNilData(err, "data", g.data) {
+			return -1
+		}
 
-		for isSortable && i < leftSlice.Len() && j < rightSlice.Len() {
-			isLeftLowerThanRight := false
+		dataValue, _, _, dataValueLen := inspectData(g.data)
 
-			leftElem := leftSlice.Index(i)
-			leftValue := callFuncSliceLoop(callbackValue, leftElem, i, 1)[0]
+		if !isSlice(err, "data", dataValue) {
+			return -1
+		}
 
-			rightElem := rightSlice.Index(j)
-			rightValue := callFuncSliceLoop(callbackValue, rightElem, j, 1)[0]
+		callbackValue, callbackType := inspectFunc(err, predicate)
+		if *err != nil {
+			return -1
+		}
 
-			switch leftValue.Kind() {
-			case reflect.String:
-				if rightValue.Kind() == reflect.String {
-					isLeftLowerThanRight = leftValue.String() <= rightValue.String()
-				} else {
-					isLeftLowerThanRight = leftValue.String() <= fmt.Sprintf("%v", rightValue.Interface())
-				}
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return -1
+		}
 
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		validateFuncOutputOneVarBool(err, callbackType, true)
+		if *err != nil {
+			return -1
+		}
 
-				switch rightValue.Kind() {
-				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-					isLeftLowerThanRight = leftValue.Int() <= rightValue.Int()
+		if dataValueLen == 0 {
+			return -1
+		}
 
-				case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-					isLeftLowerThanRight = uint64(leftValue.Int()) <= rightValue.Uint()
+		endIndex := dataValueLen
+		if len(args) > 0 {
+			endIndex = args[0]
+		}
 
-				case reflect.Float32, reflect.Float64:
-					isLeftLowerThanRight = float64(leftValue.Int()) <= rightValue.Float()
+		result := -1
 
-				case reflect.String:
-					v, _ := strconv.ParseInt(fmt.Sprintf("%v", rightValue.Interface()), base, bitSize)
-					isLeftLowerThanRight = leftValue.Int() <= v
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			if i > endIndex {
+				return true
+			}
 
-				default:
-					isSortable = false
-				}
+			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+			if res[0].Bool() {
+				result = i
+			}
 
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		})
 
-				switch rightValue.Kind() {
-				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-					isLeftLowerThanRight = leftValue.Uint() <= uint64(rightValue.Int())
+		if result < 0 {
+			return result
+		}
 
-				case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-					isLeftLowerThanRight = leftValue.Uint() <= rightValue.Uint()
+		return result
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
 
-				case reflect.Float32, reflect.Float64:
-					isLeftLowerThanRight = float64(leftValue.Uint()) <= rightValue.Float()
+	return g.markResult(result)
+}
 
-				case reflect.String:
-					v, _ := strconv.ParseUint(fmt.Sprintf("%v", rightValue.Interface()), base, bitSize)
-					isLeftLowerThanRight = leftValue.Uint() <= v
+// First function gets the first element of `data`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) First() IChainable {
+	g.lastOperation = OperationFirst
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
 
-				default:
-					isSortable = false
-				}
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-			case reflect.Float32, reflect.Float64:
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
 
-				switch rightValue.Kind() {
-				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-					isLeftLowerThanRight = leftValue.Float() <= float64(rightValue.Int())
+		dataValue, _, _, dataValueLen := inspectData(g.data)
 
-				case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-					isLeftLowerThanRight = leftValue.Float() <= float64(rightValue.Uint())
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
 
-				case reflect.Float32, reflect.Float64:
-					isLeftLowerThanRight = leftValue.Float() <= rightValue.Float()
+		if dataValueLen == 0 {
+			return nil
+		}
 
-				case reflect.String:
-					s := strings.TrimSpace(fmt.Sprintf("%v", rightValue.Interface()))
-					v := float64(0)
-					if s != "" {
-						var errConvertion error
-						v, errConvertion = strconv.ParseFloat(s, bitSize)
-						if errConvertion != nil {
-							v = 0
-						}
-					}
-					isLeftLowerThanRight = leftValue.Float() <= v
+		return dataValue.Index(0).Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
 
-				default:
-					isSortable = false
-				}
+	return g.markResult(result)
+}
 
-			default:
-				isSortable = false
-			}
+// FlatMap function creates a flattened slice of values by running each element of `data` through callback, which returns a slice, and concatenating the results. The result is slice with the same element type as the callback return value.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  callback interface{} // ==> type: `func(each anyType, i int)[]<any type>`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  the 2nd argument represents index of each element, and it's optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) FlatMap(callback interface{}) IChainable {
+	g.lastOperation = OperationFlatMap
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
 
-			if isLeftLowerThanRight {
-				if isAscending {
-					result.Index(i + j).Set(leftElem)
-					i++
-				} else {
-					result.Index(i + j).Set(rightElem)
-					j++
-				}
-			} else {
-				if isAscending {
-					result.Index(i + j).Set(rightElem)
-					j++
-				} else {
-					result.Index(i + j).Set(leftElem)
-					i++
-				}
-			}
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
 		}
 
-		if !isSortable {
-			return dataValue
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
 		}
 
-		for i < leftSlice.Len() {
-			result.Index(i + j).Set(leftSlice.Index(i))
-			i++
+		callbackValue, callbackType := inspectFunc(err, callback)
+		if *err != nil {
+			return nil
 		}
 
-		for j < rightSlice.Len() {
-			result.Index(i + j).Set(rightSlice.Index(j))
-			j++
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
 		}
 
+		validateFuncOutputOneVarDynamic(err, callbackType)
+		if *err != nil {
+			return nil
+		}
+
+		if kind := callbackType.Out(0).Kind(); kind != reflect.Slice && kind != reflect.Array {
+			*err = errors.New("callback return value data type should be slice")
+			return nil
+		}
+
+		result := makeSlice(reflect.SliceOf(callbackType.Out(0).Elem()))
+
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)[0]
+			for j := 0; j < res.Len(); j++ {
+				result = reflect.Append(result, res.Index(j))
+			}
+		})
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Flatten function flattens `data` a single level deep, e.g. `[][]int` becomes `[]int`. For `[]interface{}`, only elements which hold slice are flattened, other elements are kept as they are.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Flatten() IChainable {
+	g.lastOperation = OperationFlatten
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _flatten(err, g.data, 1)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// _flatten flattens `data` up to `depth` levels. The element type of the result is the element type of `data` after removing up to `depth` levels of slice,
+// elements with interface type are flattened based on the value they hold.
+func _flatten(err *error, data interface{}, depth int) interface{} {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, _ := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	if depth < 0 {
+		*err = errors.New("depth should not be negative number")
+		return nil
+	}
+
+	elemType := dataValue.Type().Elem()
+	for level := 0; level < depth && (elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Array); level++ {
+		elemType = elemType.Elem()
+	}
+
+	result := makeSlice(reflect.SliceOf(elemType))
+
+	var flattenInto func(slice reflect.Value, depth int)
+	flattenInto = func(slice reflect.Value, depth int) {
+		for i := 0; i < slice.Len(); i++ {
+			each := slice.Index(i)
+
+			inner := each
+			if inner.Kind() == reflect.Interface && !inner.IsNil() {
+				inner = inner.Elem()
+			}
+
+			if depth > 0 && (inner.Kind() == reflect.Slice || inner.Kind() == reflect.Array) {
+				flattenInto(inner, depth-1)
+				continue
+			}
+
+			result = reflect.Append(result, each)
+		}
+	}
+
+	flattenInto(dataValue, depth)
+	return result.Interface()
+}
+
+// FlattenDeep function recursively flattens `data` until the elements are not slice anymore, e.g. `[][][]int` becomes `[]int`. For `[]interface{}` with mixed nesting, elements which hold slice are flattened recursively, and the result is `[]interface{}`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) FlattenDeep() IChainable {
+	g.lastOperation = OperationFlattenDeep
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _flatten(err, g.data, math.MaxInt32)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// FlattenDepth function recursively flattens `data` up to `depth` levels, e.g. `[][][]int` becomes `[][]int` with depth 1, and `[]int` with depth 2.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  depth int // ==> description: the maximum recursion depth
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) FlattenDepth(depth int) IChainable {
+	g.lastOperation = OperationFlattenDepth
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _flatten(err, g.data, depth)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Frequencies function creates a map composed of unique elements of `data` as keys, and the number of their occurrences as values, e.g. `map[T]int`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Frequencies() IChainable {
+	g.lastOperation = OperationFrequencies
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		frequencies := _frequencies(err, g.data, nil)
+		if *err != nil {
+			return nil
+		}
+
+		return frequencies.toMap()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+type frequencyTable struct {
+	keyType reflect.Type
+	keys    []reflect.Value
+	counts  []int
+	firsts  []reflect.Value
+}
+
+// _frequencies counts the elements of `data` by key, the keys are in first-appearance order. If iteratee is nil, the element itself is the key.
+func _frequencies(err *error, data, iteratee interface{}) *frequencyTable {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	keyOf := func(each reflect.Value, i int) reflect.Value {
+		return each
+	}
+
+	table := &frequencyTable{keyType: dataValue.Type().Elem()}
+
+	if iteratee != nil {
+		callbackValue, callbackType := inspectFunc(err, iteratee)
+		if *err != nil {
+			return nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
+		}
+
+		validateFuncOutputOneVarDynamic(err, callbackType)
+		if *err != nil {
+			return nil
+		}
+
+		table.keyType = callbackType.Out(0)
+		keyOf = func(each reflect.Value, i int) reflect.Value {
+			return callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)[0]
+		}
+	}
+
+	if !table.keyType.Comparable() {
+		*err = errors.New("key data type should be comparable")
+		return nil
+	}
+
+	positions := make(map[interface{}]int)
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		key := keyOf(each, i)
+
+		position, ok := positions[key.Interface()]
+		if !ok {
+			position = len(table.keys)
+			positions[key.Interface()] = position
+			table.keys = append(table.keys, key)
+			table.counts = append(table.counts, 0)
+			table.firsts = append(table.firsts, each)
+		}
+
+		table.counts[position]++
+	})
+
+	return table
+}
+
+func (t *frequencyTable) toMap() interface{} {
+	result := reflect.MakeMap(reflect.MapOf(t.keyType, reflect.TypeOf(0)))
+	for i, key := range t.keys {
+		result.SetMapIndex(key, reflect.ValueOf(t.counts[i]))
+	}
+
+	return result.Interface()
+}
+
+// sortedIndexes returns the position of keys, the most frequent first. Keys with the same count are in first-appearance order.
+func (t *frequencyTable) sortedIndexes() []int {
+	indexes := make([]int, len(t.keys))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return t.counts[indexes[i]] > t.counts[indexes[j]]
+	})
+
+	return indexes
+}
+
+// FromPairs function returns an object composed from key-value `data`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) FromPairs() IChainable {
+	g.lastOperation = OperationFromPairs
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		if dataValueType.Elem().Kind() != reflect.Interface {
+			*err = errors.New("supported type only []interface{}")
+			return nil
+		}
+
+		result := make(map[interface{}]interface{}, 0)
+
+		if dataValueLen == 0 {
+			return result
+		}
+
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			if *err != nil {
+				result = nil
+				return false
+			}
+
+			eachSlice := each.Elem()
+			eachSliceLen := eachSlice.Len()
+
+			if eachSliceLen > 2 {
+				eachSliceLen = 2
+			}
+
+			if eachSliceLen > 0 {
+				eachSliceKey := eachSlice.Index(0).Interface()
+				result[eachSliceKey] = nil
+
+				if eachSliceLen > 1 {
+					eachSliceVal := eachSlice.Index(1).Interface()
+					result[eachSliceKey] = eachSliceVal
+				}
+			}
+
+			return true
+		})
+
+		return result
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// FullOuterJoin function creates a slice of combined elements of `data` and `dataToJoin` which have the same key, plus the unmatched elements of both sides. The unmatched elements of `dataToJoin` are placed at the end.
+//
+// Parameters
+//
+// This function requires four mandatory parameters:
+//  dataToJoin interface{} // ==> description: the slice to join with
+//  leftKey interface{}    // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `data`.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  rightKey interface{}   // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `dataToJoin`.
+//                         //                  the key data type should be same with the `leftKey` one.
+//  combiner interface{}   // ==> type: `func(left anyType, right anyType)<any type>`
+//                         // ==> description: the function to combine each matched pair into result element.
+//                         //                  the unmatched side is passed as zero value, or as `nil` if the parameter is pointer or interface.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) FullOuterJoin(dataToJoin, leftKey, rightKey, combiner interface{}) IChainable {
+	g.lastOperation = OperationFullOuterJoin
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _join(&err, g.data, dataToJoin, leftKey, rightKey, combiner, joinFullOuter)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// GroupBy function creates an object composed of keys generated from the results of running each element of collection thru iteratee. The order of grouped values is determined by the order they occur in collection. The corresponding value of each key is an array of elements responsible for generating the key.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  the 2nd argument represents index of each element, and it's optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Or chain with these methods to reduce each group:
+//  .Aggregate(iteratee, initial)          // ==> description: reduces each group using `Reduce()`
+//  .Count()                               // ==> description: counts the elements of each group
+//  .Sum(iteratee) / .Avg(iteratee)        // ==> description: computes the sum / average of each group, iteratee is optional
+//  .Min(iteratee) / .Max(iteratee)        // ==> description: gets the minimum / maximum element of each group, iteratee is optional
+//  .First() / .Last()                     // ==> description: gets the first / last element of each group
+//  .MapGroups(iteratee)                   // ==> description: maps each group into a value
+//  .FilterGroups(predicate)               // ==> description: keeps only the groups that the predicate returns truthy for
+//  .Rows()                                // ==> description: flattens the map into `[]KeyValue`, sorted by the key
+//
+// Or chain with `.Chain()` to continue with any other operation over the group map.
+//
+// Breaking change: `GroupBy()` used to return `IChainable`, now it returns `IChainableGroupResult`.
+// `.Result()`, `.ResultAndError()`, `.Error()` and `.IsError()` work as before. For other operations chained after `GroupBy()`,
+// insert `.Chain()` in between, e.g. `GroupBy(fn).Each(...)` becomes `GroupBy(fn).Chain().Each(...)`.
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) GroupBy(predicate interface{}) IChainableGroupResult {
+	g.lastOperation = OperationGroupBy
+	if g.IsError() || g.shouldReturn() {
+		return &resultGroup{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		callbackValue, callbackType := inspectFunc(err, predicate)
+		if *err != nil {
+			return nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
+		}
+
+		validateFuncOutputOneVarDynamic(err, callbackType)
+		if *err != nil {
+			return nil
+		}
+
+		result := reflect.MakeMap(reflect.MapOf(callbackType.Out(0), dataType))
+
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
+
+		resultMap := make(map[interface{}]reflect.Value)
+
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+			resActualValue := res[0].Interface()
+
+			if _, ok := resultMap[resActualValue]; !ok {
+				resultMap[resActualValue] = makeSlice(dataType)
+			}
+
+			mapValues := resultMap[resActualValue]
+			mapValues = reflect.Append(mapValues, each)
+			resultMap[resActualValue] = mapValues
+			result.SetMapIndex(res[0], mapValues)
+		})
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return &resultGroup{chainable: g.markError(result, err)}
+	}
+
+	return &resultGroup{chainable: g.markResult(result)}
+}
+
+// GroupByMany function groups `data` by multiple levels of keys, creating nested map, e.g. `map[K1]map[K2][]T`. Each level groups each group of the level above, like `GroupByOrdered()` operation.
+// Each predicate is invoked once per element, with the index of the element in `data`. The key of each level should be hashable.
+//
+// Parameters
+//
+// This function requires variadic mandatory parameters:
+//  predicate1 interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get the key of 1st level.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  predicate2 interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get the key of 2nd level.
+//  ...
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) GroupByMany(predicates ...interface{}) IChainable {
+	g.lastOperation = OperationGroupByMany
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _groupByMany(&err, g.data, predicates, false)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// GroupByManyOrdered function works like `GroupByMany()`, but the result is slice of `GroupNode` tree instead of nested map.
+// The nodes of each level are ordered by the first appearance of their key in `data`.
+//
+// Parameters
+//
+// This function requires variadic mandatory parameters:
+//  predicate1 interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get the key of 1st level.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  predicate2 interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get the key of 2nd level.
+//  ...
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `[]GroupNode`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `[]GroupNode`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) GroupByManyOrdered(predicates ...interface{}) IChainable {
+	g.lastOperation = OperationGroupByManyOrdered
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _groupByMany(&err, g.data, predicates, true)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+func _groupByMany(err *error, data interface{}, predicates []interface{}, isOrdered bool) interface{} {
+	defer catch(err)
+
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	if len(predicates) == 0 {
+		*err = errors.New("predicates cannot be empty")
+		return nil
+	}
+
+	// each level is grouped using `_orderedBy()` over the indexes of the elements, so each predicate is invoked once per element, with the index of the element in data
+	levelPredicates := make([]interface{}, len(predicates))
+	keyTypes := make([]reflect.Type, len(predicates))
+	for level, predicate := range predicates {
+		callbackValue, callbackType := inspectFunc(err, predicate)
+		if *err != nil {
+			return nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
+		}
+
+		validateFuncOutputOneVarDynamic(err, callbackType)
+		if *err != nil {
+			return nil
+		}
+
+		keyTypes[level] = callbackType.Out(0)
+
+		funcType := reflect.FuncOf([]reflect.Type{reflect.TypeOf(0)}, []reflect.Type{keyTypes[level]}, false)
+		levelPredicates[level] = reflect.MakeFunc(funcType, func(args []reflect.Value) []reflect.Value {
+			i := int(args[0].Int())
+			key := callFuncSliceLoop(callbackValue, dataValue.Index(i), i, callbackTypeNumIn)[0]
+			if !validateHashableKey(err, key) {
+				panic(*err)
+			}
+
+			return []reflect.Value{key}
+		}).Interface()
+	}
+
+	// the map type of each level is determined upfront, so empty levels still have the correct type
+	levelTypes := make([]reflect.Type, len(predicates)+1)
+	levelTypes[len(predicates)] = reflect.SliceOf(dataValue.Type().Elem())
+	for level := len(predicates) - 1; level >= 0; level-- {
+		levelTypes[level] = reflect.MapOf(keyTypes[level], levelTypes[level+1])
+	}
+
+	indexes := make([]int, dataValueLen)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	return _groupByLevel(err, dataValue, levelPredicates, indexes, levelTypes, isOrdered)
+}
+
+// _groupByLevel groups the elements at `indexes` by the first level predicate, then groups each group by the next levels.
+// The groups are ordered by the first appearance of their key.
+func _groupByLevel(err *error, dataValue reflect.Value, predicates []interface{}, indexes []int, levelTypes []reflect.Type, isOrdered bool) interface{} {
+	groups, _ := _orderedBy(err, indexes, predicates[0], orderedByGroup).(*OrderedMap)
+	if *err != nil {
+		return nil
+	}
+
+	itemsOf := func(groupIndexes []int) reflect.Value {
+		items := makeSlice(levelTypes[len(levelTypes)-1], 0, len(groupIndexes))
+		for _, i := range groupIndexes {
+			items = reflect.Append(items, dataValue.Index(i))
+		}
+
+		return items
+	}
+
+	if !isOrdered {
+		result := reflect.MakeMap(levelTypes[0])
+		groups.Each(func(key, value interface{}) bool {
+			keyValue := reflect.ValueOf(key)
+			if !keyValue.IsValid() {
+				keyValue = reflect.Zero(levelTypes[0].Key())
+			}
+
+			if len(predicates) == 1 {
+				result.SetMapIndex(keyValue, itemsOf(value.([]int)))
+				return true
+			}
+
+			children := _groupByLevel(err, dataValue, predicates[1:], value.([]int), levelTypes[1:], isOrdered)
+			result.SetMapIndex(keyValue, reflect.ValueOf(children))
+			return *err == nil
+		})
+
+		return result.Interface()
+	}
+
+	result := make([]GroupNode, 0, groups.Len())
+	groups.Each(func(key, value interface{}) bool {
+		node := GroupNode{Key: key, Items: itemsOf(value.([]int)).Interface()}
+		if len(predicates) > 1 {
+			node.Children, _ = _groupByLevel(err, dataValue, predicates[1:], value.([]int), levelTypes[1:], isOrdered).([]GroupNode)
+		}
+
+		result = append(result, node)
+		return *err == nil
+	})
+
+	return result
+}
+
+// GroupByOrdered function works like `GroupBy()`, but the result is an ordered map, where the keys are ordered by their first appearance.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  the 2nd argument represents index of each element, and it's optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `*OrderedMap`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `*OrderedMap`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) GroupByOrdered(predicate interface{}) IChainable {
+	g.lastOperation = OperationGroupByOrdered
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _orderedBy(&err, g.data, predicate, orderedByGroup)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+type orderedByMode int
+
+const (
+	orderedByGroup orderedByMode = iota
+	orderedByKey
+	orderedByCount
+)
+
+func _orderedBy(err *error, data, predicate interface{}, mode orderedByMode, policy ...KeyByPolicy) interface{} {
+	defer catch(err)
+
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, dataType, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	callbackValue, callbackType := inspectFunc(err, predicate)
+	if *err != nil {
+		return nil
+	}
+
+	callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+	if *err != nil {
+		return nil
+	}
+
+	validateFuncOutputOneVarDynamic(err, callbackType)
+	if *err != nil {
+		return nil
+	}
+
+	resolve := _keyByResolver(err, policy, dataValue.Type().Elem())
+	if *err != nil {
+		return nil
+	}
+
+	result := NewOrderedMap()
+	groups := make(map[interface{}]reflect.Value)
+	values := make(map[interface{}]reflect.Value)
+
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		keyValue := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)[0]
+		key := keyValue.Interface()
+
+		switch mode {
+		case orderedByGroup:
+			if _, ok := groups[key]; !ok {
+				groups[key] = makeSlice(dataType)
+			}
+
+			groups[key] = reflect.Append(groups[key], each)
+			result.Set(key, groups[key].Interface())
+
+		case orderedByKey:
+			values[key] = resolve(keyValue, values[key], each, i)
+			result.Set(key, values[key].Interface())
+
+		case orderedByCount:
+			count, _ := result.Get(key)
+			countInt, _ := count.(int)
+			result.Set(key, countInt+1)
+		}
+
+		return *err == nil
+	})
+
+	if *err != nil {
+		return nil
+	}
+
+	return result
+}
+
+// Histogram function counts numeric `data` into `binCount` bins of the same width, between the minimum and the maximum value. The result is `[]stats.HistogramBin`, each bin covers `[Lower, Upper)` except the last bin which also includes `Upper`.
+//
+// Parameters
+//
+// This function requires one mandatory parameter, and one optional parameter:
+//  binCount int         // ==> description: the number of bins
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `[]stats.HistogramBin`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `[]stats.HistogramBin`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Histogram(binCount int, args ...interface{}) IChainable {
+	g.lastOperation = OperationHistogram
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.Histogram(numbers, binCount)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// HistogramEdges function counts numeric `data` into bins defined by `edges`. The result is `[]stats.HistogramBin`, each bin covers `[Lower, Upper)` except the last bin which also includes `Upper`. Use `math.Inf(-1)` and `math.Inf(1)` as the first and last edge for open-ended bins. Values outside the edges are not counted.
+//
+// Parameters
+//
+// This function requires one mandatory parameter, and one optional parameter:
+//  edges []float64      // ==> description: the edges of the bins, in strictly increasing order
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `[]stats.HistogramBin`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `[]stats.HistogramBin`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) HistogramEdges(edges []float64, args ...interface{}) IChainable {
+	g.lastOperation = OperationHistogramEdges
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.HistogramEdges(numbers, edges)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// IndexOf function gets the index at which the first occurrence of `search` is found in `data`. If `fromIndex` is negative, it's used as the offset from the end of `data`.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  search interface{} // ==> description: the value to search for.
+//  fromIndex int      // ==> optional
+//                     //     description: The index to search from
+//                     //     default value: 0
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() int                  // ==> description: return the index of found element
+//  .ResultAndError() (int, error) // ==> description: return the index of found element, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) IndexOf(search interface{}, args ...int) IChainableNumberResult {
+	g.lastOperation = OperationIndexOf
+	if g.IsError() || g.shouldReturn() {
+		return &resultIndexOf{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) int {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return -1
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return -1
+		}
+
+		startIndex := 0
+		if len(args) > 0 {
+			startIndex = args[0]
+		}
+
+		if startIndex >= dataValueLen {
+			return -1
+		}
+
+		if dataValueLen == 0 {
+			return -1
+		}
+
+		result := -1
+
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			if startIndex > -1 {
+				if startIndex > 0 && i < startIndex {
+					return true
+				}
+
+				if each.Interface() == search && result == -1 {
+					result = i
+					return false
+				}
+			} else {
+				if i > (startIndex*-1)-1 {
+					return true
+				}
+
+				iFromRight := dataValueLen - i - 1
+				eachFromRight := dataValue.Index(iFromRight)
+
+				if eachFromRight.Interface() == search {
+					result = iFromRight
+					return true
+				}
+			}
+
+			return true
+		})
+
+		return result
+	}(&err)
+	if err != nil {
+		return &resultIndexOf{chainable: g.markError(result, err)}
+	}
+
+	return &resultIndexOf{chainable: g.markResult(result)}
+}
+
+// Initial function gets all but the last element of `data`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Initial() IChainable {
+	g.lastOperation = OperationInitial
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		if dataValueLen == 0 {
+			return makeSlice(dataType).Interface()
+		}
+
+		return dataValue.Slice(0, dataValueLen-1).Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// InnerJoin function creates a slice of combined elements of `data` and `dataToJoin` which have the same key. It uses hash join, keys which hold non-comparable value (slice, map, or struct with interface field holding one of them) are matched using deep equality, and `nil` keys never match. The order of result values is determined by `data`, then by `dataToJoin`.
+//
+// Parameters
+//
+// This function requires four mandatory parameters:
+//  dataToJoin interface{} // ==> description: the slice to join with
+//  leftKey interface{}    // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `data`.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  rightKey interface{}   // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `dataToJoin`.
+//                         //                  the key data type should be same with the `leftKey` one.
+//  combiner interface{}   // ==> type: `func(left anyType, right anyType)<any type>`
+//                         // ==> description: the function to combine each matched pair into result element.
+//                         //                  the parameter can also be pointer of the element type.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) InnerJoin(dataToJoin, leftKey, rightKey, combiner interface{}) IChainable {
+	g.lastOperation = OperationInnerJoin
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _join(&err, g.data, dataToJoin, leftKey, rightKey, combiner, joinInner)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+type joinType int
+
+const (
+	joinInner joinType = iota
+	joinLeft
+	joinRight
+	joinFullOuter
+	joinSemi
+	joinAnti
+)
+
+func _join(err *error, data, dataToJoin, leftKey, rightKey, combiner interface{}, kind joinType) interface{} {
+	defer catch(err)
+
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	if !isNonNilData(err, "data to join", dataToJoin) {
+		return nil
+	}
+
+	dataValue, dataType, _, dataValueLen := inspectData(data)
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	otherValue, _, _, otherValueLen := inspectData(dataToJoin)
+	if !isSlice(err, "data to join", otherValue) {
+		return nil
+	}
+
+	inspectKeyFunc := func(keyFunc interface{}, data reflect.Value) (reflect.Value, reflect.Type, int) {
+		keyFuncValue, keyFuncType := inspectFunc(err, keyFunc)
+		if *err != nil {
+			return keyFuncValue, keyFuncType, 0
+		}
+
+		keyFuncTypeNumIn := validateFuncInputForSliceLoop(err, keyFuncType, data)
+		if *err != nil {
+			return keyFuncValue, keyFuncType, 0
+		}
+
+		validateFuncOutputOneVarDynamic(err, keyFuncType)
+		return keyFuncValue, keyFuncType, keyFuncTypeNumIn
+	}
+
+	leftKeyValue, leftKeyType, leftKeyTypeNumIn := inspectKeyFunc(leftKey, dataValue)
+	if *err != nil {
+		return nil
+	}
+
+	rightKeyValue, rightKeyType, rightKeyTypeNumIn := inspectKeyFunc(rightKey, otherValue)
+	if *err != nil {
+		return nil
+	}
+
+	if !isTypeEqual(err, "left key", leftKeyType.Out(0), "right key", rightKeyType.Out(0)) {
+		return nil
+	}
+
+	// build the hash index from the right side, then probe it with each element of the left side
+	index := newJoinIndex()
+	forEachSlice(otherValue, otherValueLen, func(each reflect.Value, i int) {
+		index.add(callFuncSliceLoop(rightKeyValue, each, i, rightKeyTypeNumIn)[0], i)
+	})
+
+	matches := make([][]int, dataValueLen)
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		matches[i] = index.lookup(callFuncSliceLoop(leftKeyValue, each, i, leftKeyTypeNumIn)[0])
+	})
+
+	if kind == joinSemi || kind == joinAnti {
+		result := makeSlice(dataType)
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			if (len(matches[i]) > 0) == (kind == joinSemi) {
+				result = reflect.Append(result, each)
+			}
+		})
+
+		return result.Interface()
+	}
+
+	combinerValue, combinerType := inspectFunc(err, combiner)
+	if *err != nil {
+		return nil
+	}
+
+	if combinerType.NumIn() != 2 {
+		*err = errors.New("combiner must have two parameters")
+		return nil
+	}
+
+	validateFuncOutputOneVarDynamic(err, combinerType)
+	if *err != nil {
+		return nil
+	}
+
+	// the combiner parameter is either the element type, pointer of the element type, or interface
+	makeArg := func(label string, paramType reflect.Type, elemType reflect.Type) func(reflect.Value) reflect.Value {
+		switch {
+		case elemType.AssignableTo(paramType):
+			return func(each reflect.Value) reflect.Value {
+				if !each.IsValid() {
+					return reflect.Zero(paramType)
+				}
+
+				return each
+			}
+		case paramType.Kind() == reflect.Ptr && elemType.AssignableTo(paramType.Elem()):
+			return func(each reflect.Value) reflect.Value {
+				if !each.IsValid() {
+					return reflect.Zero(paramType)
+				}
+
+				pointer := reflect.New(paramType.Elem())
+				pointer.Elem().Set(each)
+				return pointer
+			}
+		}
+
+		*err = fmt.Errorf("combiner %s parameter's data type should be same with slice element data type, or pointer of it", label)
+		return nil
+	}
+
+	leftArg := makeArg("1st", combinerType.In(0), dataValue.Type().Elem())
+	if *err != nil {
+		return nil
+	}
+
+	rightArg := makeArg("2nd", combinerType.In(1), otherValue.Type().Elem())
+	if *err != nil {
+		return nil
+	}
+
+	result := makeSlice(reflect.SliceOf(combinerType.Out(0)))
+	combine := func(left, right reflect.Value) {
+		res := combinerValue.Call([]reflect.Value{leftArg(left), rightArg(right)})
+		result = reflect.Append(result, res[0])
+	}
+
+	if kind == joinRight {
+		leftMatches := make([][]int, otherValueLen)
+		for i, rightIndexes := range matches {
+			for _, j := range rightIndexes {
+				leftMatches[j] = append(leftMatches[j], i)
+			}
+		}
+
+		forEachSlice(otherValue, otherValueLen, func(each reflect.Value, j int) {
+			if len(leftMatches[j]) == 0 {
+				combine(reflect.Value{}, each)
+			}
+
+			for _, i := range leftMatches[j] {
+				combine(dataValue.Index(i), each)
+			}
+		})
+
+		return result.Interface()
+	}
+
+	isRightMatched := make([]bool, otherValueLen)
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		if len(matches[i]) == 0 && kind != joinInner {
+			combine(each, reflect.Value{})
+		}
+
+		for _, j := range matches[i] {
+			isRightMatched[j] = true
+			combine(each, otherValue.Index(j))
+		}
+	})
+
+	if kind == joinFullOuter {
+		forEachSlice(otherValue, otherValueLen, func(each reflect.Value, j int) {
+			if !isRightMatched[j] {
+				combine(reflect.Value{}, each)
+			}
+		})
+	}
+
+	return result.Interface()
+}
+
+// Interleave function creates a slice by taking one element from `data` and each other slice in turn, e.g. `[1, 2, 3]` and `[4, 5]` become `[1, 4, 2, 5, 3]`.
+// Once a slice runs out of elements it is skipped, so the remaining elements of the longer slices are appended in turn.
+//
+// Parameters
+//
+// This function requires optional variadic parameters:
+//  sliceToInterleave1 interface{} // ==> description: the slice to interleave
+//  sliceToInterleave2 interface{} // ==> description: the slice to interleave
+//  sliceToInterleave3 interface{} // ==> description: the slice to interleave
+//  ...
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Interleave(slicesToInterleave ...interface{}) IChainable {
+	g.lastOperation = OperationInterleave
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		slices := []reflect.Value{dataValue}
+		length := dataValueLen
+		longest := dataValueLen
+		for i, eachData := range slicesToInterleave {
+			eachLabel := fmt.Sprintf("interleave data %d", i+1)
+
+			if !isNonNilData(err, eachLabel, eachData) {
+				return nil
+			}
+
+			eachValue, _, _, eachValueLen := inspectData(eachData)
+
+			if !isSlice(err, eachLabel, eachValue) {
+				return nil
+			}
+
+			if eachValue.Type().Elem() != dataValue.Type().Elem() {
+				*err = errors.New("data type of each elements between slice must be same")
+				return nil
+			}
+
+			slices = append(slices, eachValue)
+			length += eachValueLen
+			if eachValueLen > longest {
+				longest = eachValueLen
+			}
+		}
+
+		result := makeSlice(reflect.SliceOf(dataValue.Type().Elem()), 0, length)
+		for i := 0; i < longest; i++ {
+			for _, slice := range slices {
+				if i < slice.Len() {
+					result = reflect.Append(result, slice.Index(i))
+				}
+			}
+		}
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Intersection function creates a slice of unique values that are included in all given slice. The order and references of result values are determined by the first slice.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  dataToIntersect interface{} // ==> description: the slice to intersect
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Intersection(dataIntersect interface{}) IChainable {
+	g.lastOperation = OperationIntersection
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _intersection(&err, g.data, dataIntersect)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// IntersectionMany function creates a slice of unique values that are included in all given slices. The order and references of result values are determined by the first slice.
+//
+// Parameters
+//
+// This function requires optional variadic parameters:
+//  dataToIntersect1 interface{} // ==> description: the slice to intersect
+//  dataToIntersect2 interface{} // ==> description: the slice to intersect
+//  dataToIntersect3 interface{} // ==> description: the slice to intersect
+//  ...
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) IntersectionMany(dataToIntersects ...interface{}) IChainable {
+	g.lastOperation = OperationIntersection
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	if len(dataToIntersects) == 0 {
+		return g.markError(nil, errors.New("data intersects cannot be nil"))
+	}
+
+	err := (error)(nil)
+	result := _intersection(&err, g.data, dataToIntersects...)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+func _intersection(err *error, data interface{}, dataIntersects ...interface{}) interface{} {
+	defer catch(err)
+
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, dataType, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	type CompareMap struct {
+		Value reflect.Value
+		Len   int
+	}
+	compareValueInReflect := make([]CompareMap, 0)
+
+	for _, compare := range dataIntersects {
+		eachValue, _, _, eachValueLen := inspectData(compare)
+
+		if isSlice(err, "data", eachValue) {
+			compareValueInReflect = append(compareValueInReflect, CompareMap{
+				Value: eachValue,
+				Len:   eachValueLen,
+			})
+		} else {
+			*err = errors.New("All data should be slice")
+			return nil
+		}
+	}
+
+	result := makeSlice(dataType)
+
+	if dataValueLen == 0 {
+		return result.Interface()
+	}
+
+	resultMap := make(map[interface{}]bool)
+
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		eachActualValue := each.Interface()
+
+		isValueExists := true
+		isJustStarted := true
+
+		for _, eachCompare := range compareValueInReflect {
+			isInnerExists := false
+
+			forEachSliceStoppable(eachCompare.Value, eachCompare.Len, func(inner reflect.Value, j int) bool {
+				if eachActualValue == inner.Interface() {
+					isInnerExists = true
+					return false
+				}
+
+				return true
+			})
+
+			if isJustStarted {
+				isValueExists = isInnerExists
+			} else {
+				isValueExists = isValueExists && isInnerExists
+			}
+		}
+
+		if isValueExists {
+			if _, ok := resultMap[eachActualValue]; !ok {
+				resultMap[eachActualValue] = true
+				result = reflect.Append(result, each)
+			}
+		}
+	})
+
+	return result.Interface()
+}
+
+// Intersperse function creates a slice of `data` with `separator` inserted between each pair of adjacent elements.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  separator interface{} // ==> description: the element to insert, its data type should be same with the element of `data`.
+//                        //                  `nil` can be used for element of nilable data type, e.g. pointer or interface.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Intersperse(separator interface{}) IChainable {
+	g.lastOperation = OperationIntersperse
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		elemType := dataValue.Type().Elem()
+
+		separatorValue := reflect.Zero(elemType)
+		if separator != nil {
+			separatorValue = reflect.ValueOf(separator)
+			if !separatorValue.Type().AssignableTo(elemType) {
+				*err = errors.New("separator data type should be same with data element type")
+				return nil
+			}
+		} else {
+			switch elemType.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+			default:
+				*err = errors.New("separator cannot be nil")
+				return nil
+			}
+		}
+
+		result := makeSlice(reflect.SliceOf(elemType), 0, dataValueLen*2)
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			if i > 0 {
+				result = reflect.Append(result, separatorValue)
+			}
+
+			result = reflect.Append(result, each)
+		})
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// IsSorted function checks if `data` is sorted in ascending order, i.e. each key is not lower than the previous one.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> optional
+//                       //     type: `func(each anyType)<any type>` or `*Expression`, e.g. `Expr("lower(Name)")`
+//                       //     description: the function to get the sort key of each element, the same as the one used by `OrderBy()`.
+//                       //                  if not provided, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() bool                  // ==> description: returns true if data is sorted, else false
+//  .ResultAndError() (bool, error) // ==> description: returns true if data is sorted, else false, and error object
+//  .Error() error                  // ==> description: returns error object
+//  .IsError() bool                 // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) IsSorted(iteratee ...interface{}) IChainableBoolResult {
+	g.lastOperation = OperationIsSorted
+	if g.IsError() || g.shouldReturn() {
+		return &resultIsSorted{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) bool {
+		defer catch(err)
+
+		return _isSorted(err, g.data, iteratee)
+	}(&err)
+	if err != nil {
+		return &resultIsSorted{chainable: g.markError(result, err)}
+	}
+
+	return &resultIsSorted{chainable: g.markResult(result)}
+}
+
+// Join function converts all elements in `data` into a string separated by `separator`.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  separator string // ==> description: the element joiner
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() string                  // ==> description: returns the result after operation
+//  .ResultAndError() (string, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                    // ==> description: returns error object
+//  .IsError() bool                   // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Join(separator string) IChainableStringResult {
+	g.lastOperation = OperationJoin
+	if g.IsError() || g.shouldReturn() {
+		return &resultJoin{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) string {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return ""
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return ""
+		}
+
+		if val, ok := g.data.([]string); ok {
+			return strings.Join(val, separator)
+		}
+
+		if dataValueLen == 0 {
+			return ""
+		}
+
+		dataInStringSlice := make([]string, 0)
+
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+
+			if each.Interface() != nil {
+				target := each
+
+				if each.Kind() == reflect.Interface {
+					target = each.Elem()
+				}
+
+				if target.Kind() == reflect.String {
+					dataInStringSlice = append(dataInStringSlice, target.String())
+				} else {
+					dataInStringSlice = append(dataInStringSlice, fmt.Sprintf("%v", target.Interface()))
+				}
+			}
+		})
+
+		if len(dataInStringSlice) == 0 {
+			return ""
+		}
+
+		return strings.Join(dataInStringSlice, separator)
+	}(&err)
+	if err != nil {
+		return &resultJoin{chainable: g.markError(result, err)}
+	}
+
+	return &resultJoin{chainable: g.markResult(result)}
+}
+
+type keyByMode int
+
+const (
+	keyByKeepLast keyByMode = iota
+	keyByKeepFirst
+	keyByErrorOnDuplicate
+	keyByMerge
+)
+
+// KeyByPolicy decides which element is kept by `KeyBy()` when multiple elements generate the same key
+type KeyByPolicy struct {
+	mode     keyByMode
+	combiner interface{}
+}
+
+var (
+	// KeyByKeepLast keeps the last element of duplicated key. This is the default policy.
+	KeyByKeepLast = KeyByPolicy{mode: keyByKeepLast}
+
+	// KeyByKeepFirst keeps the first element of duplicated key
+	KeyByKeepFirst = KeyByPolicy{mode: keyByKeepFirst}
+
+	// KeyByErrorOnDuplicate stops the operation with `*DuplicateKeyError` on duplicated key
+	KeyByErrorOnDuplicate = KeyByPolicy{mode: keyByErrorOnDuplicate}
+)
+
+// KeyByMerge merges the elements of duplicated key using combiner `func(existing anyType, each anyType) anyType`.
+// Both parameters and the return value must have the same data type as the element.
+func KeyByMerge(combiner interface{}) KeyByPolicy {
+	return KeyByPolicy{mode: keyByMerge, combiner: combiner}
+}
+
+// DuplicateKeyError is the error returned by `KeyBy()` with `KeyByErrorOnDuplicate` policy
+type DuplicateKeyError struct {
+	Key         interface{}
+	FirstIndex  int
+	SecondIndex int
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("duplicate key %v found at index %d and %d", e.Key, e.FirstIndex, e.SecondIndex)
+}
+
+// _keyByResolver returns function to decide the value of the key, given the existing value (invalid if the key is new)
+func _keyByResolver(err *error, policy []KeyByPolicy, elemType reflect.Type) func(key, existing, each reflect.Value, i int) reflect.Value {
+	mode := keyByKeepLast
+	if len(policy) > 0 {
+		mode = policy[0].mode
+	}
+
+	switch mode {
+	case keyByKeepFirst:
+		return func(key, existing, each reflect.Value, i int) reflect.Value {
+			if existing.IsValid() {
+				return existing
+			}
+
+			return each
+		}
+
+	case keyByErrorOnDuplicate:
+		firstIndexes := make(map[interface{}]int)
+		return func(key, existing, each reflect.Value, i int) reflect.Value {
+			if existing.IsValid() {
+				*err = &DuplicateKeyError{Key: key.Interface(), FirstIndex: firstIndexes[key.Interface()], SecondIndex: i}
+				return existing
+			}
+
+			firstIndexes[key.Interface()] = i
+			return each
+		}
+
+	case keyByMerge:
+		combinerValue, combinerType := inspectFunc(err, policy[0].combiner)
+		if *err != nil {
+			return nil
+		}
+
+		if combinerType.NumIn() != 2 || combinerType.NumOut() != 1 ||
+			combinerType.In(0) != elemType || combinerType.In(1) != elemType || combinerType.Out(0) != elemType {
+			*err = errors.New("combiner must have two parameters and one return value, all with the same data type as the element")
+			return nil
+		}
+
+		return func(key, existing, each reflect.Value, i int) reflect.Value {
+			if existing.IsValid() {
+				return combinerValue.Call([]reflect.Value{existing, each})[0]
+			}
+
+			return each
+		}
+	}
+
+	return func(key, existing, each reflect.Value, i int) reflect.Value {
+		return each
+	}
+}
+
+// KeyBy function creates an object composed of keys generated from the results of running each element of collection thru iteratee. By default, the corresponding value of each key is the last element responsible for generating the key.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>` or
+//                        //           `func(value anyType, key anyType, i int)<any type>`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                        //                  and both are optional.
+//  policy KeyByPolicy    // ==> optional
+//                        //     description: decides which element is kept when multiple elements generate the same key.
+//                        //                  `KeyByKeepLast`, `KeyByKeepFirst`, `KeyByErrorOnDuplicate` or `KeyByMerge(combiner)`.
+//                        //                  with `KeyByErrorOnDuplicate`, the error is `*DuplicateKeyError`.
+//                        //     default value: `KeyByKeepLast`
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) KeyBy(predicate interface{}, policy ...KeyByPolicy) IChainable {
+	g.lastOperation = OperationKeyBy
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		callbackValue, callbackType := inspectFunc(err, predicate)
+		if *err != nil {
+			return nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
+		}
+
+		validateFuncOutputOneVarDynamic(err, callbackType)
+		if *err != nil {
+			return nil
+		}
+
+		valueElemType := dataValueType.Elem()
+		result := reflect.MakeMap(reflect.MapOf(callbackType.Out(0), valueElemType))
+
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
+
+		resolve := _keyByResolver(err, policy, valueElemType)
+		if *err != nil {
+			return nil
+		}
+
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+			result.SetMapIndex(res[0], resolve(res[0], result.MapIndex(res[0]), each, i))
+			return *err == nil
+		})
+
+		if *err != nil {
+			return nil
+		}
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// KeyByOrdered function works like `KeyBy()`, but the result is an ordered map, where the keys are ordered by their first appearance.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  the 2nd argument represents index of each element, and it's optional.
+//  policy KeyByPolicy    // ==> optional
+//                        //     description: decides which element is kept when multiple elements generate the same key.
+//                        //     default value: `KeyByKeepLast`
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `*OrderedMap`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `*OrderedMap`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) KeyByOrdered(predicate interface{}, policy ...KeyByPolicy) IChainable {
+	g.lastOperation = OperationKeyByOrdered
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _orderedBy(&err, g.data, predicate, orderedByKey, policy...)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Last function gets the last element of `data`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Last() IChainable {
+	g.lastOperation = OperationLast
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		if dataValueLen == 0 {
+			return nil
+		}
+
+		return dataValue.Index(dataValueLen - 1).Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// LastIndexOf function iterates the element from tail to head, then return the index at which the first occurrence of `search` is found in `data`. If `fromIndex` is negative, it's used as the offset from the end of `data`.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  search interface{} // ==> description: the value to search for.
+//  fromIndex int      // ==> optional
+//                     //     description: The index to search from
+//                     //     default value: len(data)-1
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() int                  // ==> description: return the index of found element
+//  .ResultAndError() (int, error) // ==> description: return the index of found element, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) LastIndexOf(search interface{}, args ...int) IChainableNumberResult {
+	g.lastOperation = OperationLast
+	if g.IsError() || g.shouldReturn() {
+		return &resultLastIndexOf{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) int {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return -1
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return -1
+		}
+
+		startIndex := dataValueLen - 1
+		if len(args) > 0 {
+			startIndex = args[0]
+		}
+
+		if dataValueLen == 0 {
+			return -1
+		}
+
+		result := -1
+
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			if startIndex > -1 {
+				iFromRight := startIndex - i
+				if iFromRight > (dataValueLen-1) || iFromRight < 0 {
+					return true
+				}
+
+				eachFromRight := dataValue.Index(iFromRight)
+				if eachFromRight.Interface() == search && result == -1 {
+					result = iFromRight
+					return true
+				}
+			} else {
+				iFromRight := dataValueLen + startIndex - i
+				if iFromRight > (dataValueLen-1) || iFromRight < 0 {
+					return true
+				}
+
+				eachFromRight := dataValue.Index(iFromRight)
+				if eachFromRight.Interface() == search && result == -1 {
+					result = iFromRight
+					return true
+				}
+			}
+
+			return true
+		})
+
+		return result
+	}(&err)
+	if err != nil {
+		return &resultLastIndexOf{chainable: g.markError(result, err)}
+	}
+
+	return &resultLastIndexOf{chainable: g.markResult(result)}
+}
+
+// LeftJoin function creates a slice of combined elements of `data` and `dataToJoin` which have the same key, plus the unmatched elements of `data`. The order of result values is determined by `data`, then by `dataToJoin`.
+//
+// Parameters
+//
+// This function requires four mandatory parameters:
+//  dataToJoin interface{} // ==> description: the slice to join with
+//  leftKey interface{}    // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `data`.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  rightKey interface{}   // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `dataToJoin`.
+//                         //                  the key data type should be same with the `leftKey` one.
+//  combiner interface{}   // ==> type: `func(left anyType, right anyType)<any type>`
+//                         // ==> description: the function to combine each matched pair into result element.
+//                         //                  the unmatched side is passed as zero value, or as `nil` if the parameter is pointer or interface.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) LeftJoin(dataToJoin, leftKey, rightKey, combiner interface{}) IChainable {
+	g.lastOperation = OperationLeftJoin
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _join(&err, g.data, dataToJoin, leftKey, rightKey, combiner, joinLeft)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Map function creates an array of values by running each element in `data` thru iteratee.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  callback interface{} // ==> type: `func(each anyType, i int)<any type>` or
+//                       //           `func(value anyType, key anyType, i int)<any type>`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                       //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                       //                  and both are optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Map(callback interface{}) IChainable {
+	g.lastOperation = OperationMap
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		callbackValue, callbackType := inspectFunc(err, callback)
+		if *err != nil {
+			return nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
+		}
+
+		validateFuncOutputOneVarDynamic(err, callbackType)
+		if *err != nil {
+			return nil
+		}
+
+		result := makeSlice(reflect.SliceOf(callbackType.Out(0)))
+
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
+
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+			result = reflect.Append(result, res[0])
+		})
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Max function gets the maximum value of `data`. If iteratee is provided, the maximum iteratee result is returned. NaN is propagated: if any value is NaN, the first NaN is returned. It returns error if `data` is empty. Use `MaxBy()` to get the element instead.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<any type>`
+//                       // ==> description: the function to get the value to compare of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the maximum value
+//  .ResultAndError() (interface{}, error) // ==> description: returns the maximum value, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Max(args ...interface{}) IChainable {
+	g.lastOperation = OperationMax
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		extreme := _extreme(err, g.data, args, 1)
+		if *err != nil {
+			return nil
+		}
+
+		if extreme.index < 0 {
+			*err = errors.New("data cannot be empty")
+			return nil
+		}
+
+		return extreme.value.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// MaxBy function gets the element of `data` with the maximum iteratee result, and its index. If multiple elements have the maximum value, the first one is returned.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<any type>`
+//                       // ==> description: the function to get the value to compare of each element. the value should be number, string, bool, or time.Time.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                       // ==> description: returns the element with the maximum value, or `nil` if `data` is empty
+//  .Index() int                                // ==> description: returns index of the element, or -1 if `data` is empty
+//  .ResultAndError() (interface{}, int, error) // ==> description: returns the element with the maximum value, or `nil` if `data` is empty, its index, and error object
+//  .Error() error                              // ==> description: returns error object
+//  .IsError() bool                             // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) MaxBy(iteratee interface{}) IChainableIndexedResult {
+	g.lastOperation = OperationMaxBy
+	if g.IsError() || g.shouldReturn() {
+		return &resultMaxBy{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := _extremeBy(&err, g.data, iteratee, 1)
+	if err != nil {
+		return &resultMaxBy{chainable: g.markError(result, err)}
+	}
+
+	return &resultMaxBy{chainable: g.markResult(result)}
+}
+
+// Mean function computes the arithmetic mean of `data`, for all numeric kinds. The sum is computed using the same method as `Sum()`, so large integers don't overflow.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() float64                  // ==> description: returns the mean
+//  .ResultAndError() (float64, error) // ==> description: returns the mean, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Mean(args ...interface{}) IChainableFloatResult {
+	g.lastOperation = OperationMean
+	if g.IsError() || g.shouldReturn() {
+		return &resultMean{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		accumulator := _sum(err, g.data, args, false)
+		if *err != nil {
+			return nil
+		}
+
+		if accumulator.count == 0 {
+			*err = errors.New("data cannot be empty")
+			return nil
+		}
+
+		return accumulator.mean()
+	}(&err)
+	if err != nil {
+		return &resultMean{chainable: g.markError(result, err)}
+	}
+
+	return &resultMean{chainable: g.markResult(result)}
+}
+
+// Median function computes the median of numeric `data`. If `data` has even length, the median is the average of the two middle values.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() float64                  // ==> description: returns the median
+//  .ResultAndError() (float64, error) // ==> description: returns the median, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Median(args ...interface{}) IChainableFloatResult {
+	g.lastOperation = OperationMedian
+	if g.IsError() || g.shouldReturn() {
+		return &resultMedian{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.Median(numbers)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return &resultMedian{chainable: g.markError(result, err)}
+	}
+
+	return &resultMedian{chainable: g.markResult(result)}
+}
+
+// MergeSorted function merges sorted `data` and the other sorted slices into a single sorted slice using k-way merge, it takes O(n log k).
+// Elements with the same key keep their original order, elements of `data` first, then the other slices in the given order.
+// All slices should be sorted in ascending order, e.g. using `OrderBy()` with the same iteratee, otherwise error is returned.
+//
+// Parameters
+//
+// This function requires optional variadic parameters:
+//  sliceToMerge1 interface{} // ==> description: the sorted slice to merge, its element type should be same with `data`.
+//  sliceToMerge2 interface{} // ==> description: the sorted slice to merge
+//  sliceToMerge3 interface{} // ==> description: the sorted slice to merge
+//  ...
+//  iteratee interface{}      // ==> optional, as the last parameter
+//                            //     type: `func(each anyType)<any type>` or `*Expression`, e.g. `Expr("lower(Name)")`
+//                            //     description: the function to get the sort key of each element, the same as the one used by `OrderBy()`.
+//                            //                  if not provided, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) MergeSorted(slicesToMerge ...interface{}) IChainable {
+	g.lastOperation = OperationMergeSorted
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _mergeSorted(err, g.data, slicesToMerge)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Min function gets the minimum value of `data`. If iteratee is provided, the minimum iteratee result is returned. NaN is propagated: if any value is NaN, the first NaN is returned. It returns error if `data` is empty. Use `MinBy()` to get the element instead.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<any type>`
+//                       // ==> description: the function to get the value to compare of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the minimum value
+//  .ResultAndError() (interface{}, error) // ==> description: returns the minimum value, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Min(args ...interface{}) IChainable {
+	g.lastOperation = OperationMin
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		extreme := _extreme(err, g.data, args, -1)
+		if *err != nil {
+			return nil
+		}
+
+		if extreme.index < 0 {
+			*err = errors.New("data cannot be empty")
+			return nil
+		}
+
+		return extreme.value.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// MinBy function gets the element of `data` with the minimum iteratee result, and its index. If multiple elements have the minimum value, the first one is returned.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<any type>`
+//                       // ==> description: the function to get the value to compare of each element. the value should be number, string, bool, or time.Time.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                       // ==> description: returns the element with the minimum value, or `nil` if `data` is empty
+//  .Index() int                                // ==> description: returns index of the element, or -1 if `data` is empty
+//  .ResultAndError() (interface{}, int, error) // ==> description: returns the element with the minimum value, or `nil` if `data` is empty, its index, and error object
+//  .Error() error                              // ==> description: returns error object
+//  .IsError() bool                             // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) MinBy(iteratee interface{}) IChainableIndexedResult {
+	g.lastOperation = OperationMinBy
+	if g.IsError() || g.shouldReturn() {
+		return &resultMinBy{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := _extremeBy(&err, g.data, iteratee, -1)
+	if err != nil {
+		return &resultMinBy{chainable: g.markError(result, err)}
+	}
+
+	return &resultMinBy{chainable: g.markResult(result)}
+}
+
+type extremeValue struct {
+	element reflect.Value
+	value   reflect.Value
+	index   int
+}
+
+// _extreme finds the minimum (direction -1) or maximum (direction 1) value of `data`
+func _extreme(err *error, data interface{}, args []interface{}, direction int) extremeValue {
+	extreme := extremeValue{index: -1}
+
+	if !isNonNilData(err, "data", data) {
+		return extreme
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return extreme
+	}
+
+	valueOf := elementIteratee(err, "element", dataValue.Type().Elem(), args, false)
+	if *err != nil {
+		return extreme
+	}
+
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		value := valueOf(each)
+		if extreme.index < 0 || isNaNValue(value) {
+			extreme = extremeValue{element: each, value: value, index: i}
+			return !isNaNValue(value)
+		}
+
+		res, ok := compareValues(value, extreme.value)
+		if !ok {
+			*err = fmt.Errorf("cannot compare %v with %v", value.Interface(), extreme.value.Interface())
+			return false
+		}
+
+		if res*direction > 0 {
+			extreme = extremeValue{element: each, value: value, index: i}
+		}
+
+		return true
+	})
+
+	return extreme
+}
+
+func _extremeBy(err *error, data, iteratee interface{}, direction int) interface{} {
+	defer catch(err)
+
+	if iteratee == nil {
+		*err = errors.New("iteratee cannot be nil")
+		return nil
+	}
+
+	extreme := _extreme(err, data, []interface{}{iteratee}, direction)
+	if *err != nil {
+		return nil
+	}
+
+	if extreme.index < 0 {
+		return indexedValue{index: -1}
+	}
+
+	return indexedValue{value: extreme.element.Interface(), index: extreme.index}
+}
+
+// Mode function gets the most common element(s) of `data`. If multiple elements have the same highest number of occurrences, all of them are returned in first-appearance order.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Mode() IChainable {
+	g.lastOperation = OperationMode
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		frequencies := _frequencies(err, g.data, nil)
+		if *err != nil {
+			return nil
+		}
+
+		result := makeSlice(reflect.SliceOf(frequencies.keyType))
+		maxCount := 0
+		for i, count := range frequencies.counts {
+			if count > maxCount {
+				maxCount = count
+				result = makeSlice(reflect.SliceOf(frequencies.keyType))
+			}
+
+			if count == maxCount {
+				result = reflect.Append(result, frequencies.keys[i])
+			}
+		}
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// MostFrequent function works like `CountByKey()`, but the result is an ordered map where the most frequent key comes first. Keys with the same count are in first-appearance order.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  the 2nd argument represents index of each element, and it's optional.
+//                       //                  if not provided, the element itself is used as the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `*OrderedMap`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `*OrderedMap`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) MostFrequent(args ...interface{}) IChainable {
+	g.lastOperation = OperationMostFrequent
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		var iteratee interface{}
+		if len(args) > 0 {
+			iteratee = args[0]
+		}
+
+		frequencies := _frequencies(err, g.data, iteratee)
+		if *err != nil {
+			return nil
+		}
+
+		result := NewOrderedMap()
+		for _, i := range frequencies.sortedIndexes() {
+			result.Set(frequencies.keys[i].Interface(), frequencies.counts[i])
+		}
+
+		return result
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Nth function gets the element at index `n` of `data`. If `n` is negative, the nth element from the end is returned.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  index int // ==> description: the index of the element to return
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Nth(index int) IChainable {
+	g.lastOperation = OperationNth
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		if dataValueLen == 0 {
+			return nil
+		}
+
+		if index < 0 {
+			index = dataValueLen + index
+		}
+
+		if index < dataValueLen {
+			return dataValue.Index(index).Interface()
+		}
+
+		return nil
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// OrderBy sort slices. If orders is unspecified, all values are sorted in ascending order. Otherwise, specify an order of "desc" for descending or "asc" for ascending sort order of corresponding values. The algorithm used is merge sort, as per savigo's post on https://sagivo.com/go-sort-faster-4869bdabc670
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)<any type>` or
+//                        //           `func(value anyType, key anyType, i int)<any type>` or
+//                        //           `*Expression`, e.g. `Expr("lower(Name)")`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                        //                  and both are optional.
+//  isAscending bool      // ==> optional
+//                        //     description: the sort order. `true` for ascending, and `false` for descending.
+//                        //     default value: true
+//  isAsync bool          // ==> optional
+//                        //     description: concurrent sort. set to `true` to enable pararel sorting (faster for certain data structure)
+//                        //     default value: false
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) OrderBy(predicate interface{}, args ...bool) IChainable {
+	g.lastOperation = OperationOrderBy
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _orderBy(&err, g.data, predicate, args...)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+func _orderBy(err *error, data, callback interface{}, args ...bool) interface{} {
+	defer catch(err)
+
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, dataValueType, _, _ := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	callback = bindExpression(err, callback, dataValue, false)
+	if *err != nil {
+		return nil
+	}
+
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return nil
+	}
+
+	validateFuncInputForSliceLoopWithoutIndex(err, callbackType, dataValue)
+	if *err != nil {
+		return nil
+	}
+
+	validateFuncOutputOneVarDynamic(err, callbackType)
+	if *err != nil {
+		return nil
+	}
+
+	isAscending := true
+	if len(args) > 0 {
+		isAscending = args[0]
+	}
+
+	isAsync := false
+	if len(args) > 1 {
+		isAsync = args[1]
+	}
+
+	// =====
+
+	var _doSortAsync func(reflect.Value, chan reflect.Value)
+	var _doSortSync func(reflect.Value) reflect.Value
+	var _doMerge func(reflect.Value, reflect.Value) reflect.Value
+
+	_doSortAsync = func(slice reflect.Value, c chan reflect.Value) {
+		sliceLen := slice.Len()
+
+		if sliceLen < -1 {
+			c <- _doSortSync(slice)
+			return
+		}
+
+		if sliceLen < 2 {
+			c <- slice
+			return
+		}
+
+		mid := sliceLen / 2
+
+		c1 := make(chan reflect.Value, 1)
+		c2 := make(chan reflect.Value, 1)
+
+		go _doSortAsync(slice.Slice(0, mid), c1)
+		go _doSortAsync(slice.Slice(mid, sliceLen), c2)
+
+		go func() {
+			c <- _doMerge(<-c1, <-c2)
+		}()
+	}
+
+	_doSortSync = func(slice reflect.Value) reflect.Value {
+		sliceLen := slice.Len()
+		if sliceLen < 2 {
+			return slice
+		}
+
+		mid := sliceLen / 2
+		leftSlice := _doSortSync(slice.Slice(0, mid))
+		rightSlice := _doSortSync(slice.Slice(mid, slice.Len()))
+
+		return _doMerge(leftSlice, rightSlice)
+	}
+
+	_doMerge = func(leftSlice, rightSlice reflect.Value) reflect.Value {
+		bitSize := 64
+		base := 10
+
+		resultLen := leftSlice.Len() + rightSlice.Len()
+		result := makeSlice(dataValueType, resultLen, resultLen)
+
+		isSortable := true
+
+		var i, j int
+
+		for isSortable && i < leftSlice.Len() && j < rightSlice.Len() {
+			isLeftLowerThanRight := false
+
+			leftElem := leftSlice.Index(i)
+			leftValue := callFuncSliceLoop(callbackValue, leftElem, i, 1)[0]
+
+			rightElem := rightSlice.Index(j)
+			rightValue := callFuncSliceLoop(callbackValue, rightElem, j, 1)[0]
+
+			switch leftValue.Kind() {
+			case reflect.String:
+				if rightValue.Kind() == reflect.String {
+					isLeftLowerThanRight = leftValue.String() <= rightValue.String()
+				} else {
+					isLeftLowerThanRight = leftValue.String() <= fmt.Sprintf("%v", rightValue.Interface())
+				}
+
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+
+				switch rightValue.Kind() {
+				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+					isLeftLowerThanRight = leftValue.Int() <= rightValue.Int()
+
+				case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+					isLeftLowerThanRight = uint64(leftValue.Int()) <= rightValue.Uint()
+
+				case reflect.Float32, reflect.Float64:
+					isLeftLowerThanRight = float64(leftValue.Int()) <= rightValue.Float()
+
+				case reflect.String:
+					v, _ := strconv.ParseInt(fmt.Sprintf("%v", rightValue.Interface()), base, bitSize)
+					isLeftLowerThanRight = leftValue.Int() <= v
+
+				default:
+					isSortable = false
+				}
+
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+
+				switch rightValue.Kind() {
+				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+					isLeftLowerThanRight = leftValue.Uint() <= uint64(rightValue.Int())
+
+				case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+					isLeftLowerThanRight = leftValue.Uint() <= rightValue.Uint()
+
+				case reflect.Float32, reflect.Float64:
+					isLeftLowerThanRight = float64(leftValue.Uint()) <= rightValue.Float()
+
+				case reflect.String:
+					v, _ := strconv.ParseUint(fmt.Sprintf("%v", rightValue.Interface()), base, bitSize)
+					isLeftLowerThanRight = leftValue.Uint() <= v
+
+				default:
+					isSortable = false
+				}
+
+			case reflect.Float32, reflect.Float64:
+
+				switch rightValue.Kind() {
+				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+					isLeftLowerThanRight = leftValue.Float() <= float64(rightValue.Int())
+
+				case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+					isLeftLowerThanRight = leftValue.Float() <= float64(rightValue.Uint())
+
+				case reflect.Float32, reflect.Float64:
+					isLeftLowerThanRight = leftValue.Float() <= rightValue.Float()
+
+				case reflect.String:
+					s := strings.TrimSpace(fmt.Sprintf("%v", rightValue.Interface()))
+					v := float64(0)
+					if s != "" {
+						var errConvertion error
+						v, errConvertion = strconv.ParseFloat(s, bitSize)
+						if errConvertion != nil {
+							v = 0
+						}
+					}
+					isLeftLowerThanRight = leftValue.Float() <= v
+
+				default:
+					isSortable = false
+				}
+
+			default:
+				isSortable = false
+			}
+
+			if isLeftLowerThanRight {
+				if isAscending {
+					result.Index(i + j).Set(leftElem)
+					i++
+				} else {
+					result.Index(i + j).Set(rightElem)
+					j++
+				}
+			} else {
+				if isAscending {
+					result.Index(i + j).Set(rightElem)
+					j++
+				} else {
+					result.Index(i + j).Set(leftElem)
+					i++
+				}
+			}
+		}
+
+		if !isSortable {
+			return dataValue
+		}
+
+		for i < leftSlice.Len() {
+			result.Index(i + j).Set(leftSlice.Index(i))
+			i++
+		}
+
+		for j < rightSlice.Len() {
+			result.Index(i + j).Set(rightSlice.Index(j))
+			j++
+		}
+
+		return result
+	}
+
+	if isAsync {
+		c := make(chan reflect.Value)
+		_doSortAsync(dataValue, c)
+
+		return (<-c).Interface()
+	}
+
+	return _doSortSync(dataValue).Interface()
+}
+
+// Pairwise function creates a slice of adjacent pairs of `data`, i.e. `[data[0], data[1]]`, `[data[1], data[2]]`, and so on. Each pair is slice of two elements.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Pairwise() IChainable {
+	g.lastOperation = OperationPairwise
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _window(err, g.data, 2, 1)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Partition function creates an array of elements split into two groups, the first of which contains elements predicate returns truthy for, the second of which contains elements predicate returns falsey for. The predicate is invoked with one argument: (value).
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  the 2nd argument represents index of each element, and it's optional.
+//                        //                  and both are optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .ResultTruthy() interface{}                         // ==> description: return slice of elements which predicate returns truthy for
+//  .ResultFalsey() interface{}                         // ==> description: return slice of elements which predicate returns falsey for
+//  .ResultAndError() (interface{}, interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                                      // ==> description: returns error object
+//  .IsError() bool                                     // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Partition(callback interface{}) IChainableTwoReturnValueResult {
+	g.lastOperation = OperationPartition
+	if g.IsError() || g.shouldReturn() {
+		return &resultPartition{chainable: g}
+	}
+
+	err := (error)(nil)
+	truhty, falsey := func(err *error) (interface{}, interface{}) {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil, nil
+		}
+
+		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil, nil
+		}
+
+		callbackValue, callbackType := inspectFunc(err, callback)
+		if *err != nil {
+			return nil, nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil, nil
+		}
+
+		validateFuncOutputOneVarBool(err, callbackType, true)
+		if *err != nil {
+			return nil, nil
+		}
+
+		resultTruhty := makeSlice(dataValueType)
+		resultFalsey := makeSlice(dataValueType)
+
+		if dataValueLen == 0 {
+			return resultTruhty, resultFalsey
+		}
+
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+
+			if res[0].Bool() {
+				resultTruhty = reflect.Append(resultTruhty, each)
+			} else {
+				resultFalsey = reflect.Append(resultFalsey, each)
+			}
+		})
+
+		return resultTruhty.Interface(), resultFalsey.Interface()
+	}(&err)
+	if err != nil {
+		return &resultPartition{chainable: g.markError([]interface{}{truhty, falsey}, err)}
+	}
+
+	return &resultPartition{chainable: g.markResult([]interface{}{truhty, falsey})}
+}
+
+// Percentile function computes the `p`-th percentile of numeric `data`. The `method` decides the value when the percentile lies between two data points, e.g. `stats.PercentileLinear` or `stats.PercentileNearest`.
+//
+// Parameters
+//
+// This function requires two mandatory parameters, and one optional parameter:
+//  p float64                     // ==> description: the percentile to compute, between 0 and 100
+//  method stats.PercentileMethod // ==> description: the interpolation method
+//  iteratee interface{}          // ==> type: `func(each anyType)<number type>`
+//                                // ==> description: the function to get the number of each element.
+//                                //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() float64                  // ==> description: returns the percentile
+//  .ResultAndError() (float64, error) // ==> description: returns the percentile, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Percentile(p float64, method stats.PercentileMethod, args ...interface{}) IChainableFloatResult {
+	g.lastOperation = OperationPercentile
+	if g.IsError() || g.shouldReturn() {
+		return &resultPercentile{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.Percentile(numbers, p, method)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return &resultPercentile{chainable: g.markError(result, err)}
+	}
+
+	return &resultPercentile{chainable: g.markResult(result)}
+}
+
+// Permutations function creates all `k`-length permutations of elements of `data`, as slice of tuples, in lexicographic order of the element position.
+// The number of tuples is limited by `CombinatoricsLimit`, use `PermutationsIterator()` to generate the tuples one by one instead.
+//
+// Parameters
+//
+// This function requires one mandatory parameter, and one optional parameter:
+//  k int                    // ==> description: the length of each tuple
+//  limit CombinatoricsLimit // ==> description: the maximum number of tuples, `DefaultCombinatoricsLimit` if not provided.
+//                           //                  zero or negative number disables the limit.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Permutations(k int, limit ...CombinatoricsLimit) IChainable {
+	g.lastOperation = OperationPermutations
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		it, count := _permutationsIterator(err, g.data, k)
+		if *err != nil {
+			return nil
+		}
+
+		return _combinatoricsCollect(err, it, count, _combinatoricsLimit(limit))
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// PowerSet function creates all subsets of `data`, as slice of tuples. The subsets are ordered by their length, starting from the empty subset, then in the same order as `Combinations()`.
+// The number of tuples is limited by `CombinatoricsLimit`, use `PowerSetIterator()` to generate the tuples one by one instead.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  limit CombinatoricsLimit // ==> description: the maximum number of tuples, `DefaultCombinatoricsLimit` if not provided.
+//                           //                  zero or negative number disables the limit.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) PowerSet(limit ...CombinatoricsLimit) IChainable {
+	g.lastOperation = OperationPowerSet
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		it, count := _powerSetIterator(err, g.data)
+		if *err != nil {
+			return nil
+		}
+
+		return _combinatoricsCollect(err, it, count, _combinatoricsLimit(limit))
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Product function creates the cartesian product of `data` and the other slices, as slice of tuples. The rightmost element advances the fastest.
+// Each tuple is `[]T` when all slices have the same element type, otherwise `[]interface{}`.
+// The number of tuples is limited by `CombinatoricsLimit`, use `ProductIterator()` to generate the tuples one by one instead.
+//
+// Parameters
+//
+// This function requires optional variadic parameters:
+//  sliceToMultiply1 interface{} // ==> description: the slice to multiply
+//  sliceToMultiply2 interface{} // ==> description: the slice to multiply
+//  sliceToMultiply3 interface{} // ==> description: the slice to multiply
+//  ...
+//  limit CombinatoricsLimit     // ==> description: the maximum number of tuples, `DefaultCombinatoricsLimit` if not provided.
+//                               //                  zero or negative number disables the limit.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Product(slicesToMultiply ...interface{}) IChainable {
+	g.lastOperation = OperationProduct
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		others, limit := _productArgs(slicesToMultiply)
+
+		it, count := _productIterator(err, g.data, others)
+		if *err != nil {
+			return nil
+		}
+
+		return _combinatoricsCollect(err, it, count, limit)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Quantile function computes the `q`-th quantile of numeric `data`. The `method` decides the value when the quantile lies between two data points, e.g. `stats.PercentileLinear` or `stats.PercentileNearest`.
+//
+// Parameters
+//
+// This function requires two mandatory parameters, and one optional parameter:
+//  q float64                     // ==> description: the quantile to compute, between 0 and 1
+//  method stats.PercentileMethod // ==> description: the interpolation method
+//  iteratee interface{}          // ==> type: `func(each anyType)<number type>`
+//                                // ==> description: the function to get the number of each element.
+//                                //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() float64                  // ==> description: returns the quantile
+//  .ResultAndError() (float64, error) // ==> description: returns the quantile, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Quantile(q float64, method stats.PercentileMethod, args ...interface{}) IChainableFloatResult {
+	g.lastOperation = OperationQuantile
+	if g.IsError() || g.shouldReturn() {
+		return &resultPercentile{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.Quantile(numbers, q, method)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return &resultPercentile{chainable: g.markError(result, err)}
+	}
+
+	return &resultPercentile{chainable: g.markResult(result)}
+}
+
+// Reduce function reduces collection to a value which is the accumulated result of running each element in collection thru iteratee, where each successive invocation is supplied the return value of the previous. If accumulator is not given, the first element of collection is used as the initial value.
+//
+// Parameters
+//
+// This function require two mandatory parameters:
+//  iteratee interface{} // ==> type: `func(accumulator <any type>, each anyType, i int)<any type>` or
+//                       //           `func(accumulator <any type>, value anyType, key anyType, i int)<any type>`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  the 1st argument is the accumulator. at first the value is coming from `initial`
+//                       //                  for slice, the 3rd argument represents index of each element, and it's optional.
+//                       //                  for struct object/map, the 3rd and 4th arguments represent key and index of each item respectively,
+//                       //                  and both are optional.
+//                       //                  the iteratee may also return `(<any type>, error)`. returning `ErrStopIteration` stops the iteration,
+//                       //                  and the returned accumulator becomes the result. other errors stop the operation.
+//  initial interface{}  // ==> description: the initial value.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Reduce(iteratee, initial interface{}) IChainable {
+	g.lastOperation = OperationReduce
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataValueType, dataValueKind, dataValueLen := inspectData(g.data)
+		if dataValueLen == 0 {
+			return initial
+		}
+
+		if !isSlice(err, "data", dataValue) {
+			if dataValueKind == reflect.Map {
+				*err = nil
+				return _reduceCollection(err, dataValue, dataValueType, dataValueKind, dataValueLen, iteratee, initial)
+			}
+
+			return nil
+		}
+
+		return _reduceSlice(err, dataValue, dataValueType, dataValueKind, dataValueLen, iteratee, initial)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// ErrStopIteration is the sentinel error returned by the iteratee of `Reduce()`, `ReduceRight()` and `Scan()` to stop the iteration early.
+// The accumulator returned along with it is used as the last accumulator.
+var ErrStopIteration = errors.New("stop iteration")
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+func _reduceCollection(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback, initial interface{}) interface{} {
+
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return nil
+	}
+
+	initialValue, initialValueType, _, _ := inspectData(initial)
+
+	callbackValueNumIn := callbackType.NumIn()
+	if callbackValueNumIn < 2 || callbackValueNumIn > 3 {
+		*err = errors.New("callback must only have two or three parameters")
+		return nil
+	}
+
+	if callbackType.In(0).Kind() != initialValueType.Kind() {
+		*err = errors.New("callback 1st parameter's data type should be same with initial value's data type")
+		return nil
+	}
+
+	if callbackType.In(1).Kind() != dataValueType.Elem().Kind() {
+		*err = errors.New("callback 2nd parameter's data type should be same with map value data type")
+		return nil
+	}
+
+	if callbackValueNumIn > 2 {
+		if callbackType.In(2).Kind() != dataValueType.Key().Kind() {
+			*err = errors.New("callback 3rd parameter's data type should be same with map key type")
+			return nil
+		}
+	}
+
+	isStoppable := validateFuncOutputAccumulator(err, callbackType)
+	if *err != nil {
+		return nil
+	}
+
+	result := initialValue
+
+	dataValueMapKeys := dataValue.MapKeys()
+	forEachCollectionStoppable(dataValue, dataValueMapKeys, func(value, key reflect.Value, i int) bool {
+		args := []reflect.Value{result, value}
+		if callbackValueNumIn > 2 {
+			args = append(args, key)
+		}
+
+		shouldContinue := false
+		result, shouldContinue = callReducer(err, callbackValue, args, isStoppable)
+		return shouldContinue
+	})
+	if *err != nil {
+		return nil
+	}
+
+	return result.Interface()
+}
+
+func _reduceSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback, initial interface{}) interface{} {
+	result := reflect.ValueOf(initial)
+	_foldSlice(err, dataValue, dataValueType, dataValueLen, callback, initial, false, func(accumulator reflect.Value) {
+		result = accumulator
+	})
+	if *err != nil {
+		return nil
+	}
+
+	return result.Interface()
+}
+
+// _foldSlice calls `callback` for each element of slice, from the beginning or from the end, until it returns `ErrStopIteration`.
+// Each accumulator is passed to `onEach`. It returns the accumulator data type.
+func _foldSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueLen int, callback, initial interface{}, fromRight bool, onEach func(reflect.Value)) reflect.Type {
+
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return nil
+	}
+
+	initialValue, initialValueType, _, _ := inspectData(initial)
+
+	callbackValueNumIn := callbackType.NumIn()
+	if callbackValueNumIn < 2 || callbackValueNumIn > 3 {
+		*err = errors.New("callback must only have two or three parameters")
+		return nil
+	}
+
+	if callbackType.In(0).Kind() != initialValueType.Kind() {
+		*err = errors.New("callback 1st parameter's data type should be same with initial value's data type")
+		return nil
+	}
+
+	if callbackType.In(1).Kind() != dataValueType.Elem().Kind() {
+		*err = errors.New("callback 2nd parameter's data type should be same with slice element data type")
+		return nil
+	}
+
+	if callbackValueNumIn > 2 {
+		if callbackType.In(2).Kind() != reflect.Int {
+			*err = errors.New("callback 3rd parameter's data type should be int")
+			return nil
+		}
+	}
+
+	isStoppable := validateFuncOutputAccumulator(err, callbackType)
+	if *err != nil {
+		return nil
+	}
+
+	accumulator := initialValue
+
+	for k := 0; k < dataValueLen; k++ {
+		i := k
+		if fromRight {
+			i = dataValueLen - 1 - k
+		}
+
+		args := []reflect.Value{accumulator, dataValue.Index(i)}
+		if callbackValueNumIn > 2 {
+			args = append(args, reflect.ValueOf(i))
+		}
+
+		shouldContinue := false
+		accumulator, shouldContinue = callReducer(err, callbackValue, args, isStoppable)
+		if *err != nil {
+			return nil
+		}
+
+		onEach(accumulator)
+
+		if !shouldContinue {
+			break
+		}
+	}
+
+	return callbackType.Out(0)
+}
+
+// validateFuncOutputAccumulator validates that reducer returns the accumulator, optionally followed by an error.
+// It returns `true` if the reducer returns the error as well.
+func validateFuncOutputAccumulator(err *error, callbackType reflect.Type) bool {
+	if callbackType.NumOut() == 2 && callbackType.Out(1) == errorType {
+		return true
+	}
+
+	validateFuncOutputOneVarDynamic(err, callbackType)
+	return false
+}
+
+// callReducer calls reducer and reports whether the iteration should continue.
+// The iteration stops on `ErrStopIteration`, meanwhile other errors are stored into `err`.
+func callReducer(err *error, reducer reflect.Value, args []reflect.Value, isStoppable bool) (reflect.Value, bool) {
+	res := reducer.Call(args)
+	if !isStoppable || res[1].IsNil() {
+		return res[0], true
+	}
+
+	if reducerErr := res[1].Interface().(error); !errors.Is(reducerErr, ErrStopIteration) {
+		*err = reducerErr
+	}
+
+	return res[0], false
+}
+
+// ReduceRight function is like `Reduce()` except that it iterates over elements of slice from right to left.
+//
+// Parameters
+//
+// This function require two mandatory parameters:
+//  iteratee interface{} // ==> type: `func(accumulator <any type>, each anyType, i int)<any type>` or
+//                       //           `func(accumulator <any type>, each anyType, i int)(<any type>, error)`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  the 1st argument is the accumulator. at first the value is coming from `initial`
+//                       //                  the 3rd argument represents index of each element, and it's optional.
+//                       //                  returning `ErrStopIteration` stops the iteration, other errors stop the operation.
+//  initial interface{}  // ==> description: the initial value.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) ReduceRight(iteratee, initial interface{}) IChainable {
+	g.lastOperation = OperationReduceRight
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
+		if dataValueLen == 0 {
+			return initial
+		}
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		result := reflect.ValueOf(initial)
+		_foldSlice(err, dataValue, dataValueType, dataValueLen, iteratee, initial, true, func(accumulator reflect.Value) {
+			result = accumulator
+		})
+		if *err != nil {
+			return nil
+		}
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Reject function iterates over elements of slice or struct object or map, returning an array of all elements predicate returns FALSEY for.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
+//                        //           `func(value anyType, key anyType, i int)bool` or
+//                        //           `*Expression`, e.g. `Expr("Age >= 18")`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
+//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
+//                        //                  and both are optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Reject(predicate interface{}) IChainable {
+	g.lastOperation = OperationReject
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		predicate := bindExpression(err, predicate, dataValue, true)
+		if *err != nil {
+			return nil
+		}
+
+		callbackValue, callbackType := inspectFunc(err, predicate)
+		if *err != nil {
+			return nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
+		}
+
+		validateFuncOutputOneVarBool(err, callbackType, true)
+		if *err != nil {
+			return nil
+		}
+
+		result := makeSlice(dataType)
+
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
+
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
+			if !res[0].Bool() {
+				result = reflect.Append(result, each)
+			}
+		})
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Resample function aggregates `data` into fixed intervals, e.g. per minute. The aggregator is called once for each interval between the first and the last element, in time order, including intervals without any element.
+// The intervals are computed the same way as `BinByTime()`. The result is slice of the aggregator return values.
+//
+// Parameters
+//
+// This function requires three mandatory parameters:
+//  interval time.Duration  // ==> description: the length of each interval
+//  timeFn interface{}      // ==> type: `func(each anyType)time.Time`
+//                          // ==> description: the function to get the time of each element.
+//                          //                  if `nil`, the element itself is used.
+//  aggregator interface{}  // ==> type: `func(start time.Time, items []anyType)<any type>`
+//                          // ==> description: the function to aggregate elements of each interval. `items` is empty for interval without any element.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Resample(interval time.Duration, timeFn, aggregator interface{}) IChainable {
+	g.lastOperation = OperationResample
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _resample(err, g.data, interval, timeFn, aggregator)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Reverse function reverses `data` so that the first element becomes the last, the second element becomes the second to last, and so on.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Reverse() IChainable {
+	g.lastOperation = OperationReverse
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		result := makeSlice(dataType)
+
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
+
+		for i := 0; i < dataValueLen; i++ {
+			result = reflect.Append(result, dataValue.Index(dataValueLen-1-i))
+		}
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// RightJoin function creates a slice of combined elements of `data` and `dataToJoin` which have the same key, plus the unmatched elements of `dataToJoin`. The order of result values is determined by `dataToJoin`, then by `data`.
+//
+// Parameters
+//
+// This function requires four mandatory parameters:
+//  dataToJoin interface{} // ==> description: the slice to join with
+//  leftKey interface{}    // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `data`.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  rightKey interface{}   // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `dataToJoin`.
+//                         //                  the key data type should be same with the `leftKey` one.
+//  combiner interface{}   // ==> type: `func(left anyType, right anyType)<any type>`
+//                         // ==> description: the function to combine each matched pair into result element.
+//                         //                  the unmatched side is passed as zero value, or as `nil` if the parameter is pointer or interface.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) RightJoin(dataToJoin, leftKey, rightKey, combiner interface{}) IChainable {
+	g.lastOperation = OperationRightJoin
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _join(&err, g.data, dataToJoin, leftKey, rightKey, combiner, joinRight)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Rolling function calls reducer with sliding window of each element of `data`, which is the element and up to `size - 1` elements before it, e.g. to compute moving average.
+// The first `size - 1` windows are shorter than `size`. The result is slice of the reducer return values, with the same length as `data`.
+//
+// Parameters
+//
+// This function requires two mandatory parameters:
+//  size int             // ==> description: the maximum number of elements in each window
+//  reducer interface{}  // ==> type: `func(window []anyType)<any type>`
+//                       // ==> description: the function to reduce each window
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Rolling(size int, reducer interface{}) IChainable {
+	g.lastOperation = OperationRolling
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		series, seriesLen := _seriesData(err, g.data)
+		if *err != nil {
+			return nil
+		}
+
+		if size <= 0 {
+			*err = errors.New("size should be greater than zero")
+			return nil
+		}
+
+		return _rolling(err, series, seriesLen, reducer, func(i int) int {
+			if i < size {
+				return 0
+			}
+
+			return i - size + 1
+		})
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// RollingBy function calls reducer with sliding time window of each element of `data`, which is the element and the elements before it within `duration`, i.e. time in `(t - duration, t]`.
+// `data` should be sorted by time in ascending order. The result is slice of the reducer return values, with the same length as `data`.
+//
+// Parameters
+//
+// This function requires three mandatory parameters:
+//  duration time.Duration // ==> description: the length of each window
+//  timeFn interface{}     // ==> type: `func(each anyType)time.Time`
+//                         // ==> description: the function to get the time of each element.
+//                         //                  if `nil`, the element itself is used.
+//  reducer interface{}    // ==> type: `func(window []anyType)<any type>`
+//                         // ==> description: the function to reduce each window
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) RollingBy(duration time.Duration, timeFn, reducer interface{}) IChainable {
+	g.lastOperation = OperationRollingBy
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _rollingBy(err, g.data, duration, timeFn, reducer)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Rotate function creates a slice of `data` with elements rotated by `n` positions. Positive `n` rotates to the left, so the element at index `n` becomes the first element,
+// meanwhile negative `n` rotates to the right. `n` greater than the length of `data` wraps around.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  n int // ==> description: the number of positions to rotate
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Rotate(n int) IChainable {
+	g.lastOperation = OperationRotate
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		result := makeSlice(reflect.SliceOf(dataValue.Type().Elem()), 0, dataValueLen)
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
+
+		n %= dataValueLen
+		if n < 0 {
+			n += dataValueLen
+		}
+
+		result = reflect.AppendSlice(result, dataValue.Slice(n, dataValueLen))
+		result = reflect.AppendSlice(result, dataValue.Slice(0, n))
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Sample function gets a random element from `data`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Sample() IChainable {
+	g.lastOperation = OperationSample
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		if dataValueLen == 0 {
+			return makeSlice(dataType).Interface()
+		}
+
+		return dataValue.Index(RandomInt(0, dataValueLen-1)).Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// SampleCovariance function computes the sample covariance (with Bessel's correction) between two numbers taken from each element of `data`.
+//
+// Parameters
+//
+// This function requires two mandatory parameters:
+//  iterateeX interface{} // ==> type: `func(each anyType)<number type>`
+//                        // ==> description: the function to get the first number of each element.
+//                        //                  if `nil`, the element itself is used.
+//  iterateeY interface{} // ==> type: `func(each anyType)<number type>`
+//                        // ==> description: the function to get the second number of each element.
+//                        //                  if `nil`, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() float64                  // ==> description: returns the covariance
+//  .ResultAndError() (float64, error) // ==> description: returns the covariance, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SampleCovariance(iterateeX, iterateeY interface{}) IChainableFloatResult {
+	g.lastOperation = OperationSampleCovariance
+	if g.IsError() || g.shouldReturn() {
+		return &resultCovariance{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		xs := _statsNumbers(err, g.data, []interface{}{iterateeX})
+		if *err != nil {
+			return nil
+		}
+
+		ys := _statsNumbers(err, g.data, []interface{}{iterateeY})
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.SampleCovariance(xs, ys)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return &resultCovariance{chainable: g.markError(result, err)}
+	}
+
+	return &resultCovariance{chainable: g.markResult(result)}
+}
+
+// SampleSize function gets slice of random elements from `data`.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  take int // ==> description: the length of each chunk
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SampleSize(take int) IChainable {
+	g.lastOperation = OperationSampleSize
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		if !isPositiveNumber(err, "size", take) {
+			return nil
+		}
+
+		cache := make(map[int]bool, 0)
+		result := makeSlice(dataType)
+
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
+
+		if take >= dataValueLen {
+			return g.data
+		}
+
+		for result.Len() < take {
+			n := RandomInt(0, dataValueLen-1)
+			if _, ok := cache[n]; ok {
+				continue
+			}
+
+			cache[n] = true
+			result = reflect.Append(result, dataValue.Index(n))
+		}
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// SampleStdDev function computes the sample standard deviation (with Bessel's correction) of numeric `data`. `data` should have at least 2 elements.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() float64                  // ==> description: returns the standard deviation
+//  .ResultAndError() (float64, error) // ==> description: returns the standard deviation, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SampleStdDev(args ...interface{}) IChainableFloatResult {
+	g.lastOperation = OperationSampleStdDev
+	if g.IsError() || g.shouldReturn() {
+		return &resultStdDev{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.SampleStdDev(numbers)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return &resultStdDev{chainable: g.markError(result, err)}
+	}
+
+	return &resultStdDev{chainable: g.markResult(result)}
+}
+
+// SampleVariance function computes the sample variance (with Bessel's correction) of numeric `data`. `data` should have at least 2 elements.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() float64                  // ==> description: returns the variance
+//  .ResultAndError() (float64, error) // ==> description: returns the variance, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SampleVariance(args ...interface{}) IChainableFloatResult {
+	g.lastOperation = OperationSampleVariance
+	if g.IsError() || g.shouldReturn() {
+		return &resultVariance{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.SampleVariance(numbers)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return &resultVariance{chainable: g.markError(result, err)}
+	}
+
+	return &resultVariance{chainable: g.markResult(result)}
+}
+
+// Scan function is like `Reduce()` except that it returns every intermediate accumulator, e.g. the running totals. The initial value is not included.
+//
+// Parameters
+//
+// This function require two mandatory parameters:
+//  iteratee interface{} // ==> type: `func(accumulator <any type>, each anyType, i int)<any type>` or
+//                       //           `func(accumulator <any type>, each anyType, i int)(<any type>, error)`
+//                       // ==> description: the function invoked per iteration.
+//                       //                  the 1st argument is the accumulator. at first the value is coming from `initial`
+//                       //                  the 3rd argument represents index of each element, and it's optional.
+//                       //                  returning `ErrStopIteration` stops the iteration, other errors stop the operation.
+//  initial interface{}  // ==> description: the initial value.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns slice of accumulators
+//  .ResultAndError() (interface{}, error) // ==> description: returns slice of accumulators, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Scan(iteratee, initial interface{}) IChainable {
+	g.lastOperation = OperationScan
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		accumulators := make([]reflect.Value, 0, dataValueLen)
+		accumulatorType := _foldSlice(err, dataValue, dataValueType, dataValueLen, iteratee, initial, false, func(accumulator reflect.Value) {
+			accumulators = append(accumulators, accumulator)
+		})
+		if *err != nil {
+			return nil
+		}
+
+		result := makeSlice(reflect.SliceOf(accumulatorType))
+		result = reflect.Append(result, accumulators...)
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// SemiJoin function creates a slice of elements of `data` which have matching key in `dataToJoin`. Each element is included once, regardless of the number of matches. The order of result values is determined by `data`.
+//
+// Parameters
+//
+// This function requires three mandatory parameters:
+//  dataToJoin interface{} // ==> description: the slice to join with
+//  leftKey interface{}    // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `data`.
+//                         //                  the 2nd argument represents index of each element, and it's optional.
+//  rightKey interface{}   // ==> type: `func(each anyType, i int)<any type>`
+//                         // ==> description: the function to get join key of each element of `dataToJoin`.
+//                         //                  the key data type should be same with the `leftKey` one.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SemiJoin(dataToJoin, leftKey, rightKey interface{}) IChainable {
+	g.lastOperation = OperationSemiJoin
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := _join(&err, g.data, dataToJoin, leftKey, rightKey, nil, joinSemi)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Shuffle function creates a slice of shuffled values, using a version of the Fisher-Yates shuffle.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Shuffle() IChainable {
+	g.lastOperation = OperationShuffle
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		rand.Seed(time.Now().UnixNano())
+
+		n := dataValueLen
+		for i := n - 1; i > 0; i-- {
+			j := rand.Intn(i + 1)
+
+			iValue, jValue := dataValue.Index(i).Interface(), dataValue.Index(j).Interface()
+			dataValue.Index(i).Set(reflect.ValueOf(jValue))
+			dataValue.Index(j).Set(reflect.ValueOf(iValue))
+		}
+
+		return dataValue.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// Size function gets the size of slice or struct object/map by returning its length for array-like values or the number of own enumerable string keyed properties for objects.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() int                  // ==> description: returns the length of data
+//  .ResultAndError() (int, error) // ==> description: returns the length of data, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Size() IChainable {
+	g.lastOperation = OperationSize
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) int {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return 0
+		}
+
+		dataValue, _, dataValueKind, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			if dataValueKind == reflect.String {
+				*err = nil
+				return len(g.data.(string))
+			} else if dataValueKind == reflect.Map {
+				*err = nil
+				return dataValueLen
+			}
+
+			return 0
+		}
+
+		return dataValueLen
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// SortedDifference function creates a slice of elements of sorted `data` which key does not exist in sorted `dataToCompare`. It takes O(n+m), unlike `Difference()`.
+// Both slices should be sorted in ascending order, e.g. using `OrderBy()` with the same iteratee, otherwise error is returned.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  dataToCompare interface{} // ==> description: the sorted slice to compare, its element type should be same with `data`.
+//  iteratee interface{}      // ==> optional
+//                            //     type: `func(each anyType)<any type>` or `*Expression`, e.g. `Expr("lower(Name)")`
+//                            //     description: the function to get the sort key of each element, the same as the one used by `OrderBy()`.
+//                            //                  if not provided, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SortedDifference(dataToCompare interface{}, iteratee ...interface{}) IChainable {
+	g.lastOperation = OperationSortedDifference
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _sortedSetOperation(err, g.data, dataToCompare, iteratee, false)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// SortedIndex function gets the lowest index at which `value` should be inserted into `data` in order to maintain its sort order.
+// `data` should be sorted in ascending order, e.g. using `OrderBy()` with the same iteratee. Binary search is used, so it takes O(log n).
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  value interface{}    // ==> description: the value to evaluate. if iteratee is provided, it's invoked for the value as well.
+//  iteratee interface{} // ==> optional
+//                       //     type: `func(each anyType)<any type>` or `*Expression`, e.g. `Expr("lower(Name)")`
+//                       //     description: the function to get the sort key of each element, the same as the one used by `OrderBy()`.
+//                       //                  if not provided, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() int                  // ==> description: returns the index
+//  .ResultAndError() (int, error) // ==> description: returns the index, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SortedIndex(value interface{}, iteratee ...interface{}) IChainableNumberResult {
+	g.lastOperation = OperationSortedIndex
+	if g.IsError() || g.shouldReturn() {
+		return &resultSortedIndex{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) int {
+		defer catch(err)
+
+		index, _ := _sortedSearch(err, g.data, value, iteratee, false)
+		return index
+	}(&err)
+	if err != nil {
+		return &resultSortedIndex{chainable: g.markError(result, err)}
+	}
+
+	return &resultSortedIndex{chainable: g.markResult(result)}
+}
+
+// SortedIndexOf function gets the index of the first element which key is equal to the key of `value`, or -1 if not found.
+// `data` should be sorted in ascending order, e.g. using `OrderBy()` with the same iteratee. Binary search is used, so it takes O(log n).
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  value interface{}    // ==> description: the value to evaluate. if iteratee is provided, it's invoked for the value as well.
+//  iteratee interface{} // ==> optional
+//                       //     type: `func(each anyType)<any type>` or `*Expression`, e.g. `Expr("lower(Name)")`
+//                       //     description: the function to get the sort key of each element, the same as the one used by `OrderBy()`.
+//                       //                  if not provided, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() int                  // ==> description: returns the index of found element
+//  .ResultAndError() (int, error) // ==> description: returns the index of found element, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SortedIndexOf(value interface{}, iteratee ...interface{}) IChainableNumberResult {
+	g.lastOperation = OperationSortedIndexOf
+	if g.IsError() || g.shouldReturn() {
+		return &resultSortedIndexOf{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) int {
+		defer catch(err)
+
+		index, isFound := _sortedSearch(err, g.data, value, iteratee, false)
+		if !isFound {
+			return -1
+		}
+
+		return index
+	}(&err)
+	if err != nil {
+		return &resultSortedIndexOf{chainable: g.markError(result, err)}
+	}
+
+	return &resultSortedIndexOf{chainable: g.markResult(result)}
+}
+
+// SortedIntersection function creates a slice of unique elements of sorted `data` which key also exists in sorted `dataToCompare`. It takes O(n+m), unlike `Intersection()`.
+// Both slices should be sorted in ascending order, e.g. using `OrderBy()` with the same iteratee, otherwise error is returned.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  dataToCompare interface{} // ==> description: the sorted slice to compare, its element type should be same with `data`.
+//  iteratee interface{}      // ==> optional
+//                            //     type: `func(each anyType)<any type>` or `*Expression`, e.g. `Expr("lower(Name)")`
+//                            //     description: the function to get the sort key of each element, the same as the one used by `OrderBy()`.
+//                            //                  if not provided, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SortedIntersection(dataToCompare interface{}, iteratee ...interface{}) IChainable {
+	g.lastOperation = OperationSortedIntersection
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _sortedSetOperation(err, g.data, dataToCompare, iteratee, true)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// SortedLastIndex function is like `SortedIndex()` except that it gets the highest index, i.e. after the elements with the same key as `value`.
+// `data` should be sorted in ascending order, e.g. using `OrderBy()` with the same iteratee. Binary search is used, so it takes O(log n).
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  value interface{}    // ==> description: the value to evaluate. if iteratee is provided, it's invoked for the value as well.
+//  iteratee interface{} // ==> optional
+//                       //     type: `func(each anyType)<any type>` or `*Expression`, e.g. `Expr("lower(Name)")`
+//                       //     description: the function to get the sort key of each element, the same as the one used by `OrderBy()`.
+//                       //                  if not provided, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() int                  // ==> description: returns the index
+//  .ResultAndError() (int, error) // ==> description: returns the index, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SortedLastIndex(value interface{}, iteratee ...interface{}) IChainableNumberResult {
+	g.lastOperation = OperationSortedLastIndex
+	if g.IsError() || g.shouldReturn() {
+		return &resultSortedLastIndex{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) int {
+		defer catch(err)
+
+		index, _ := _sortedSearch(err, g.data, value, iteratee, true)
+		return index
+	}(&err)
+	if err != nil {
+		return &resultSortedLastIndex{chainable: g.markError(result, err)}
+	}
+
+	return &resultSortedLastIndex{chainable: g.markResult(result)}
+}
+
+// SortedUniq function creates a duplicate-free version of sorted `data`, only the first element of each key is kept. It takes O(n), unlike `Uniq()`.
+// `data` should be sorted in ascending order, e.g. using `OrderBy()` with the same iteratee, otherwise error is returned.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> optional
+//                       //     type: `func(each anyType)<any type>` or `*Expression`, e.g. `Expr("lower(Name)")`
+//                       //     description: the function to get the sort key of each element, the same as the one used by `OrderBy()`.
+//                       //                  if not provided, the element itself is the key.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SortedUniq(iteratee ...interface{}) IChainable {
+	g.lastOperation = OperationSortedUniq
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _sortedUniq(err, g.data, iteratee)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// SplitInto function divides `data` into `n` parts with near-equal length, e.g. to distribute work between workers. The length of the parts differ by at most one,
+// the longer parts come first. The result always has `n` parts, so some parts are empty if `data` has less than `n` elements.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  n int // ==> description: the number of parts
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SplitInto(n int) IChainable {
+	g.lastOperation = OperationSplitInto
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, _, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		if n <= 0 {
+			*err = errors.New("n should be greater than zero")
+			return nil
+		}
+
+		sliceType := reflect.SliceOf(dataValue.Type().Elem())
+		result := makeSlice(reflect.SliceOf(sliceType), n, n)
+
+		start := 0
+		for i := 0; i < n; i++ {
+			partLen := dataValueLen / n
+			if i < dataValueLen%n {
+				partLen++
+			}
+
+			part := makeSlice(sliceType, partLen, partLen)
+			reflect.Copy(part, dataValue.Slice(start, start+partLen))
+			result.Index(i).Set(part)
+			start += partLen
+		}
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// SplitWhen function splits `data` into chunks of consecutive elements, a new chunk starts whenever predicate returns `true` for two consecutive elements. It is the opposite of `ChunkWhile()`.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(previous anyType, current anyType)bool`
+//                        // ==> description: the function to decide whether `current` starts a new chunk
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SplitWhen(predicate interface{}) IChainable {
+	g.lastOperation = OperationSplitWhen
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		return _splitBetween(err, g.data, predicate, true)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+// StdDev function computes the population standard deviation of numeric `data`.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() float64                  // ==> description: returns the standard deviation
+//  .ResultAndError() (float64, error) // ==> description: returns the standard deviation, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) StdDev(args ...interface{}) IChainableFloatResult {
+	g.lastOperation = OperationStdDev
+	if g.IsError() || g.shouldReturn() {
+		return &resultStdDev{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
+			return nil
+		}
+
+		result, statsErr := stats.StdDev(numbers)
+		*err = statsErr
 		return result
+	}(&err)
+	if err != nil {
+		return &resultStdDev{chainable: g.markError(result, err)}
 	}
 
-	if isAsync {
-		c := make(chan reflect.Value)
-		_doSortAsync(dataValue, c)
+	return &resultStdDev{chainable: g.markResult(result)}
+}
 
-		return (<-c).Interface()
+// Sum function computes the sum of `data`, for all numeric kinds. Integers are summed exactly without overflow, and floats are summed using compensated summation. Use `SumBig()` to get the exact sum of floats.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the sum. for integer kinds, it's `*big.Int`, for float kinds, it's `float64`
+//  .ResultAndError() (interface{}, error) // ==> description: returns the sum, and error object
+//  .Int() (int64, bool)                   // ==> description: returns the sum as `int64`, and `false` if it's not an integer which fits in `int64`
+//  .Big() *big.Int                        // ==> description: returns the sum as `*big.Int`, float sum is truncated toward zero
+//  .Float() float64                       // ==> description: returns the sum as `float64`
+//  .IsFloat() bool                        // ==> description: return `true` if the numbers are float kinds
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Sum(args ...interface{}) IChainableSumResult {
+	g.lastOperation = OperationSum
+	if g.IsError() || g.shouldReturn() {
+		return &resultSum{chainable: g}
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		accumulator := _sum(err, g.data, args, false)
+		if *err != nil {
+			return nil
+		}
+
+		if accumulator.isFloat {
+			return accumulator.float()
+		}
+
+		return accumulator.integer()
+	}(&err)
+	if err != nil {
+		return &resultSum{chainable: g.markError(result, err)}
+	}
+
+	return &resultSum{chainable: g.markResult(result)}
+}
+
+// SumBig function computes the exact sum of `data` as `*big.Rat`, for all numeric kinds. Floats are accumulated using their shortest decimal representation, e.g. `0.1 + 0.2` is exactly `3/10`.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `*big.Rat`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `*big.Rat`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) SumBig(args ...interface{}) IChainable {
+	g.lastOperation = OperationSumBig
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		accumulator := _sum(err, g.data, args, true)
+		if *err != nil {
+			return nil
+		}
+
+		return accumulator.rat()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
+	}
+
+	return g.markResult(result)
+}
+
+func _sum(err *error, data interface{}, args []interface{}, isExact bool) *numberAccumulator {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	numberOf := elementIteratee(err, "element", dataValue.Type().Elem(), args, true)
+	if *err != nil {
+		return nil
+	}
+
+	numberKind := dataValue.Type().Elem().Kind()
+	if len(args) > 0 && args[0] != nil {
+		numberKind = reflect.TypeOf(args[0]).Out(0).Kind()
+	}
+
+	accumulator := &numberAccumulator{isExact: isExact, isFloat: numberKind == reflect.Float32 || numberKind == reflect.Float64}
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		accumulator.add(err, numberOf(each))
+		return *err == nil
+	})
+
+	return accumulator
+}
+
+// Tail function gets all but the first element of `data`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() int                  // ==> description: returns the length of data
+//  .ResultAndError() (int, error) // ==> description: returns the length of data, and error object
+//  .Error() error                 // ==> description: returns error object
+//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) Tail() IChainable {
+	g.lastOperation = OperationTail
+	if g.IsError() || g.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.data) {
+			return nil
+		}
+
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+
+		if !isSlice(err, "data", dataValue) {
+			return nil
+		}
+
+		if dataValueLen == 0 {
+			return makeSlice(dataType).Interface()
+		}
+
+		result := dataValue.Slice(1, dataValueLen)
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
 	}
 
-	return _doSortSync(dataValue).Interface()
+	return g.markResult(result)
 }
 
-// Partition function creates an array of elements split into two groups, the first of which contains elements predicate returns truthy for, the second of which contains elements predicate returns falsey for. The predicate is invoked with one argument: (value).
+// Take function creates a slice of `data` with `size` elements taken from the beginning.
 //
 // Parameters
 //
 // This function requires single mandatory parameter:
-//  predicate interface{} // ==> type: `func(each anyType, i int)bool`
-//                        // ==> description: the function invoked per iteration.
-//                        //                  the 2nd argument represents index of each element, and it's optional.
-//                        //                  and both are optional.
+//  size int // ==> description: the length of each chunk
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .ResultTruthy() interface{}                         // ==> description: return slice of elements which predicate returns truthy for
-//  .ResultFalsey() interface{}                         // ==> description: return slice of elements which predicate returns falsey for
-//  .ResultAndError() (interface{}, interface{}, error) // ==> description: returns the result after operation, and error object
-//  .Error() error                                      // ==> description: returns error object
-//  .IsError() bool                                     // ==> description: return `true` on error, otherwise `false`
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Partition(callback interface{}) IChainableTwoReturnValueResult {
-	g.lastOperation = OperationPartition
+func (g *Chainable) Take(size int) IChainable {
+	g.lastOperation = OperationTake
 	if g.IsError() || g.shouldReturn() {
-		return &resultPartition{chainable: g}
+		return g
 	}
 
 	err := (error)(nil)
-	truhty, falsey := func(err *error) (interface{}, interface{}) {
+	result := func(err *error) interface{} {
 		defer catch(err)
 
 		if !isNonNilData(err, "data", g.data) {
-			return nil, nil
+			return nil
 		}
 
-		dataValue, dataValueType, _, dataValueLen := inspectData(g.data)
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
-			return nil, nil
-		}
-
-		callbackValue, callbackType := inspectFunc(err, callback)
-		if *err != nil {
-			return nil, nil
-		}
-
-		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-		if *err != nil {
-			return nil, nil
+			return nil
 		}
 
-		validateFuncOutputOneVarBool(err, callbackType, true)
-		if *err != nil {
-			return nil, nil
+		if !isZeroOrPositiveNumber(err, "size", size) {
+			return g.data
 		}
 
-		resultTruhty := makeSlice(dataValueType)
-		resultFalsey := makeSlice(dataValueType)
+		result := makeSlice(dataType)
 
 		if dataValueLen == 0 {
-			return resultTruhty, resultFalsey
+			return result.Interface()
 		}
 
 		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-
-			if res[0].Bool() {
-				resultTruhty = reflect.Append(resultTruhty, each)
-			} else {
-				resultFalsey = reflect.Append(resultFalsey, each)
+			if i < size {
+				result = reflect.Append(result, each)
 			}
 		})
 
-		return resultTruhty.Interface(), resultFalsey.Interface()
+		return result.Interface()
 	}(&err)
 	if err != nil {
-		return &resultPartition{chainable: g.markError([]interface{}{truhty, falsey}, err)}
+		return g.markError(result, err)
 	}
 
-	return &resultPartition{chainable: g.markResult([]interface{}{truhty, falsey})}
+	return g.markResult(result)
 }
 
-// Reduce function reduces collection to a value which is the accumulated result of running each element in collection thru iteratee, where each successive invocation is supplied the return value of the previous. If accumulator is not given, the first element of collection is used as the initial value.
+// TakeRight function creates a slice of `data` with `size` elements taken from the end.
 //
 // Parameters
 //
-// This function require two mandatory parameters:
-//  iteratee interface{} // ==> type: `func(accumulator <any type>, each anyType, i int)<any type>` or
-//                       //           `func(accumulator <any type>, value anyType, key anyType, i int)<any type>`
-//                       // ==> description: the function invoked per iteration.
-//                       //                  the 1st argument is the accumulator. at first the value is coming from `initial`
-//                       //                  for slice, the 3rd argument represents index of each element, and it's optional.
-//                       //                  for struct object/map, the 3rd and 4th arguments represent key and index of each item respectively,
-//                       //                  and both are optional.
-//  initial interface{}  // ==> description: the initial value.
+// This function requires single mandatory parameter:
+//  size int // ==> description: the length of each chunk
 //
 // Return values
 //
@@ -3200,8 +8279,8 @@ func (g *Chainable) Partition(callback interface{}) IChainableTwoReturnValueResu
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Reduce(iteratee, initial interface{}) IChainable {
-	g.lastOperation = OperationReduce
+func (g *Chainable) TakeRight(size int) IChainable {
+	g.lastOperation = OperationTakeRight
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -3214,21 +8293,29 @@ func (g *Chainable) Reduce(iteratee, initial interface{}) IChainable {
 			return nil
 		}
 
-		dataValue, dataValueType, dataValueKind, dataValueLen := inspectData(g.data)
-		if dataValueLen == 0 {
-			return initial
-		}
+		dataValue, dataType, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
-			if dataValueKind == reflect.Map {
-				*err = nil
-				return _reduceCollection(err, dataValue, dataValueType, dataValueKind, dataValueLen, iteratee, initial)
-			}
-
 			return nil
 		}
 
-		return _reduceSlice(err, dataValue, dataValueType, dataValueKind, dataValueLen, iteratee, initial)
+		if !isZeroOrPositiveNumber(err, "size", size) {
+			return g.data
+		}
+
+		result := makeSlice(dataType)
+
+		if dataValueLen == 0 {
+			return result.Interface()
+		}
+
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			if i >= (dataValueLen - size) {
+				result = reflect.Append(result, each)
+			}
+		})
+
+		return result.Interface()
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -3237,119 +8324,97 @@ func (g *Chainable) Reduce(iteratee, initial interface{}) IChainable {
 	return g.markResult(result)
 }
 
-func _reduceCollection(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback, initial interface{}) interface{} {
-
-	callbackValue, callbackType := inspectFunc(err, callback)
-	if *err != nil {
-		return nil
-	}
-
-	initialValue, initialValueType, _, _ := inspectData(initial)
-
-	callbackValueNumIn := callbackType.NumIn()
-	if callbackValueNumIn < 2 || callbackValueNumIn > 3 {
-		*err = errors.New("callback must only have two or three parameters")
-		return nil
-	}
-
-	if callbackType.In(0).Kind() != initialValueType.Kind() {
-		*err = errors.New("callback 1st parameter's data type should be same with initial value's data type")
-		return nil
-	}
-
-	if callbackType.In(1).Kind() != dataValueType.Elem().Kind() {
-		*err = errors.New("callback 2nd parameter's data type should be same with map value data type")
-		return nil
+// TakeRightWhile function creates a slice of `data` with elements taken from the end. Elements are taken until `predicate` returns falsey, the iteration stops there.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
+//                        //           `*Expression`, e.g. `Expr("Age >= 18")`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  the 2nd argument represents index of each element, and it's optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) TakeRightWhile(predicate interface{}) IChainable {
+	g.lastOperation = OperationTakeRightWhile
+	if g.IsError() || g.shouldReturn() {
+		return g
 	}
 
-	if callbackValueNumIn > 2 {
-		if callbackType.In(2).Kind() != dataValueType.Key().Kind() {
-			*err = errors.New("callback 3rd parameter's data type should be same with map key type")
-			return nil
-		}
-	}
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-	validateFuncOutputOneVarDynamic(err, callbackType)
-	if *err != nil {
-		return nil
+		return _sliceWhile(err, g.data, predicate, true, true)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
 	}
 
-	result := initialValue
-
-	dataValueMapKeys := dataValue.MapKeys()
-	forEachCollection(dataValue, dataValueMapKeys, func(value, key reflect.Value, i int) {
-		if callbackValueNumIn == 2 {
-			result = callbackValue.Call([]reflect.Value{result, value})[0]
-		} else {
-			result = callbackValue.Call([]reflect.Value{result, value, key})[0]
-		}
-	})
-
-	return result.Interface()
+	return g.markResult(result)
 }
 
-func _reduceSlice(err *error, dataValue reflect.Value, dataValueType reflect.Type, dataValueKind reflect.Kind, dataValueLen int, callback, initial interface{}) interface{} {
-
-	callbackValue, callbackType := inspectFunc(err, callback)
-	if *err != nil {
-		return nil
-	}
-
-	initialValue, initialValueType, _, _ := inspectData(initial)
-
-	callbackValueNumIn := callbackType.NumIn()
-	if callbackValueNumIn < 2 || callbackValueNumIn > 3 {
-		*err = errors.New("callback must only have two or three parameters")
-		return nil
-	}
-
-	if callbackType.In(0).Kind() != initialValueType.Kind() {
-		*err = errors.New("callback 1st parameter's data type should be same with initial value's data type")
-		return nil
-	}
-
-	if callbackType.In(1).Kind() != dataValue.Index(0).Kind() {
-		*err = errors.New("callback 2nd parameter's data type should be same with slice element data type")
-		return nil
+// TakeWhile function creates a slice of `data` with elements taken from the beginning. Elements are taken until `predicate` returns falsey, the iteration stops there.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
+//                        //           `*Expression`, e.g. `Expr("Age >= 18")`
+//                        // ==> description: the function invoked per iteration.
+//                        //                  the 2nd argument represents index of each element, and it's optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the result after operation
+//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+//
+// Examples
+//
+// List of examples available:
+func (g *Chainable) TakeWhile(predicate interface{}) IChainable {
+	g.lastOperation = OperationTakeWhile
+	if g.IsError() || g.shouldReturn() {
+		return g
 	}
 
-	if callbackValueNumIn > 2 {
-		if callbackType.In(2).Kind() != reflect.Int {
-			*err = errors.New("callback 3rd parameter's data type should be int")
-			return nil
-		}
-	}
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-	validateFuncOutputOneVarDynamic(err, callbackType)
-	if *err != nil {
-		return nil
+		return _sliceWhile(err, g.data, predicate, false, true)
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
 	}
 
-	result := initialValue
-
-	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		if callbackValueNumIn == 2 {
-			result = callbackValue.Call([]reflect.Value{result, each})[0]
-		} else {
-			result = callbackValue.Call([]reflect.Value{result, each, reflect.ValueOf(i)})[0]
-		}
-	})
-
-	return result.Interface()
+	return g.markResult(result)
 }
 
-// Reject function iterates over elements of slice or struct object or map, returning an array of all elements predicate returns FALSEY for.
+// TopK function gets the `k` elements of `data` with the largest iteratee result, the largest first. It uses heap of size `k` instead of sorting the whole `data`. Elements with the same iteratee result are in their original order.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  predicate interface{} // ==> type: `func(each anyType, i int)bool` or
-//                        //           `func(value anyType, key anyType, i int)bool`
-//                        // ==> description: the function invoked per iteration.
-//                        //                  for slice, the 2nd argument represents index of each element, and it's optional.
-//                        //                  for struct object/map, the 2nd and 3rd arguments represent key and index of each item respectively,
-//                        //                  and both are optional.
-//
+// This function requires two mandatory parameters:
+//  k int                // ==> description: the number of elements to take
+//  iteratee interface{} // ==> type: `func(each anyType, i int)<any type>`
+//                       // ==> description: the function to get the value to compare of each element. the value should be number, string, bool, or time.Time.
+//                       //                  the 2nd argument represents index of each element, and it's optional.
+//                       //                  if `nil`, the element itself is compared.
+//
 // Return values
 //
 // Chain with these methods to get result:
@@ -3361,8 +8426,8 @@ func _reduceSlice(err *error, dataValue reflect.Value, dataValueType reflect.Typ
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Reject(predicate interface{}) IChainable {
-	g.lastOperation = OperationReject
+func (g *Chainable) TopK(k int, iteratee interface{}) IChainable {
+	g.lastOperation = OperationTopK
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -3381,34 +8446,54 @@ func (g *Chainable) Reject(predicate interface{}) IChainable {
 			return nil
 		}
 
-		callbackValue, callbackType := inspectFunc(err, predicate)
-		if *err != nil {
+		if !isZeroOrPositiveNumber(err, "k", k) {
 			return nil
 		}
 
-		callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
-		if *err != nil {
-			return nil
+		valueOf := func(each reflect.Value, i int) reflect.Value {
+			return each
 		}
 
-		validateFuncOutputOneVarBool(err, callbackType, true)
-		if *err != nil {
-			return nil
-		}
+		if iteratee != nil {
+			callbackValue, callbackType := inspectFunc(err, iteratee)
+			if *err != nil {
+				return nil
+			}
 
-		result := makeSlice(dataType)
+			callbackTypeNumIn := validateFuncInputForSliceLoop(err, callbackType, dataValue)
+			if *err != nil {
+				return nil
+			}
 
-		if dataValueLen == 0 {
-			return result.Interface()
+			validateFuncOutputOneVarDynamic(err, callbackType)
+			if *err != nil {
+				return nil
+			}
+
+			valueOf = func(each reflect.Value, i int) reflect.Value {
+				return callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)[0]
+			}
 		}
 
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			res := callFuncSliceLoop(callbackValue, each, i, callbackTypeNumIn)
-			if !res[0].Bool() {
-				result = reflect.Append(result, each)
+		items := &topKHeap{err: err}
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			heap.Push(items, topKItem{element: each, value: valueOf(each, i), index: i})
+			if items.Len() > k {
+				heap.Pop(items)
 			}
+
+			return *err == nil
 		})
 
+		if *err != nil {
+			return nil
+		}
+
+		result := makeSlice(dataType, items.Len(), items.Len())
+		for i := items.Len() - 1; i >= 0; i-- {
+			result.Index(i).Set(heap.Pop(items).(topKItem).element)
+		}
+
 		return result.Interface()
 	}(&err)
 	if err != nil {
@@ -3418,11 +8503,60 @@ func (g *Chainable) Reject(predicate interface{}) IChainable {
 	return g.markResult(result)
 }
 
-// Reverse function reverses `data` so that the first element becomes the last, the second element becomes the second to last, and so on.
+type topKItem struct {
+	element reflect.Value
+	value   reflect.Value
+	index   int
+}
+
+// topKHeap is min heap, the root is the smallest value. For the same value, the later element is considered smaller.
+type topKHeap struct {
+	items []topKItem
+	err   *error
+}
+
+func (h *topKHeap) Len() int {
+	return len(h.items)
+}
+
+func (h *topKHeap) Less(i, j int) bool {
+	res, ok := compareValues(h.items[i].value, h.items[j].value)
+	if !ok {
+		*h.err = fmt.Errorf("cannot compare %v with %v", h.items[i].value.Interface(), h.items[j].value.Interface())
+		return false
+	}
+
+	if res == 0 {
+		return h.items[i].index > h.items[j].index
+	}
+
+	return res < 0
+}
+
+func (h *topKHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *topKHeap) Push(x interface{}) {
+	h.items = append(h.items, x.(topKItem))
+}
+
+func (h *topKHeap) Pop() interface{} {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// Transpose function flips matrix `data` over its diagonal, the rows become columns and vice versa. The element of `data` should be slice, and the result is `[][]anyType`.
 //
 // Parameters
 //
-// This function does not requires any parameter.
+// This function requires single optional parameter:
+//  policy ZipPolicy // ==> optional
+//                   //     description: decides what to do when the rows have different length.
+//                   //                  `ZipTruncate` drops the columns which do not exist in every row,
+//                   //                  `ZipPad` fills the missing elements with zero value, or `ZipReturnError`.
+//                   //     default value: `ZipTruncate`
 //
 // Return values
 //
@@ -3435,8 +8569,8 @@ func (g *Chainable) Reject(predicate interface{}) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Reverse() IChainable {
-	g.lastOperation = OperationReverse
+func (g *Chainable) Transpose(policy ...ZipPolicy) IChainable {
+	g.lastOperation = OperationTranspose
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -3449,20 +8583,48 @@ func (g *Chainable) Reverse() IChainable {
 			return nil
 		}
 
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+		dataValue, _, _, dataValueLen := inspectData(g.data)
 
 		if !isSlice(err, "data", dataValue) {
 			return nil
 		}
 
-		result := makeSlice(dataType)
+		rowType := dataValue.Type().Elem()
+		if rowType.Kind() != reflect.Slice && rowType.Kind() != reflect.Array {
+			*err = errors.New("data element should be slice")
+			return nil
+		}
 
-		if dataValueLen == 0 {
-			return result.Interface()
+		zipPolicy := ZipTruncate
+		if len(policy) > 0 {
+			zipPolicy = policy[0]
 		}
 
-		for i := 0; i < dataValueLen; i++ {
-			result = reflect.Append(result, dataValue.Index(dataValueLen-1-i))
+		length := 0
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			if i == 0 {
+				length = each.Len()
+				return true
+			}
+
+			length = _zipLength(err, zipPolicy, length, each.Len(), func() string {
+				return fmt.Sprintf("length of row %d should be same with length of row 0", i)
+			})
+			return *err == nil
+		})
+		if *err != nil {
+			return nil
+		}
+
+		columnType := reflect.SliceOf(rowType.Elem())
+		result := makeSlice(reflect.SliceOf(columnType), length, length)
+		for j := 0; j < length; j++ {
+			column := makeSlice(columnType, dataValueLen, dataValueLen)
+			forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+				column.Index(i).Set(_zipElement(each, j))
+			})
+
+			result.Index(j).Set(column)
 		}
 
 		return result.Interface()
@@ -3474,7 +8636,7 @@ func (g *Chainable) Reverse() IChainable {
 	return g.markResult(result)
 }
 
-// Sample function gets a random element from `data`.
+// Uniq create slice of unique values from it.
 //
 // Parameters
 //
@@ -3491,32 +8653,14 @@ func (g *Chainable) Reverse() IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Sample() IChainable {
-	g.lastOperation = OperationSample
+func (g *Chainable) Uniq() IChainable {
+	g.lastOperation = OperationUniq
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) interface{} {
-		defer catch(err)
-
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
-			return nil
-		}
-
-		if dataValueLen == 0 {
-			return makeSlice(dataType).Interface()
-		}
-
-		return dataValue.Index(RandomInt(0, dataValueLen-1)).Interface()
-	}(&err)
+	result := _union(&err, g.data)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -3524,12 +8668,15 @@ func (g *Chainable) Sample() IChainable {
 	return g.markResult(result)
 }
 
-// SampleSize function gets slice of random elements from `data`.
+// Union function combines all slices presented on the parameters, then create slice of unique values from it. All slice must have same data type.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  take int // ==> description: the length of each chunk
+// This function requires optional variadic parameters:
+//  sliceToUnion1 interface{} // ==> description: the index of item to exclude
+//  sliceToUnion2 interface{} // ==> description: the index of item to exclude
+//  sliceToUnion3 interface{} // ==> description: the index of item to exclude
+//  ...
 //
 // Return values
 //
@@ -3542,65 +8689,92 @@ func (g *Chainable) Sample() IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) SampleSize(take int) IChainable {
-	g.lastOperation = OperationSampleSize
+func (g *Chainable) UnionMany(sliceToUnion ...interface{}) IChainable {
+	g.lastOperation = OperationUnionMany
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := func(err *error) interface{} {
-		defer catch(err)
+	result := _union(&err, g.data, sliceToUnion...)
+	if err != nil {
+		return g.markError(result, err)
+	}
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
+	return g.markResult(result)
+}
+
+func _union(err *error, data interface{}, slices ...interface{}) interface{} {
+	defer catchWithCustomErrorMessage(err, func(errorMessage string) string {
+		if strings.Contains(errorMessage, "is not assignable") {
+			return "data type of each elements between slice must be same"
 		}
 
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
+		return errorMessage
+	})
 
-		if !isSlice(err, "data", dataValue) {
-			return nil
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, dataType, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	result := makeSlice(dataType)
+	resultMap := make(map[interface{}]bool, 0)
+
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		eachRealValue := each.Interface()
+
+		if _, ok := resultMap[eachRealValue]; !ok {
+			resultMap[eachRealValue] = true
+			result = reflect.Append(result, each)
 		}
+	})
 
-		if !isPositiveNumber(err, "size", take) {
+	for _, each := range slices {
+		targetValue, _, _, targetValueLen := inspectData(each)
+
+		if !isSlice(err, "data", targetValue) {
 			return nil
 		}
 
-		cache := make(map[int]bool, 0)
-		result := makeSlice(dataType)
+		if targetValueLen > 0 {
 
-		if dataValueLen == 0 {
-			return result.Interface()
-		}
+			forEachSliceStoppable(targetValue, targetValueLen, func(inner reflect.Value, j int) bool {
+				if *err != nil {
+					return false
+				}
 
-		if take >= dataValueLen {
-			return g.data
-		}
+				targetEachRealValue := inner.Interface()
 
-		for result.Len() < take {
-			n := RandomInt(0, dataValueLen-1)
-			if _, ok := cache[n]; ok {
-				continue
-			}
+				if _, ok := resultMap[targetEachRealValue]; !ok {
+					resultMap[targetEachRealValue] = true
+					result = reflect.Append(result, inner)
+				}
 
-			cache[n] = true
-			result = reflect.Append(result, dataValue.Index(n))
+				return true
+			})
 		}
-
-		return result.Interface()
-	}(&err)
-	if err != nil {
-		return g.markError(result, err)
 	}
 
-	return g.markResult(result)
+	return result.Interface()
 }
 
-// Shuffle function creates a slice of shuffled values, using a version of the Fisher-Yates shuffle.
+// Unzip function is the inverse of `Zip()`, it groups the elements of each row of `data` by their index into separate slices, e.g. to split rows into parallel columns.
+// The result is `[][]anyType` if the rows are `[]anyType`. If the rows are `[]interface{}`, the result is `[]interface{}` of typed slices,
+// each column has the data type of its values if they all have the same data type, otherwise `[]interface{}`.
 //
 // Parameters
 //
-// This function does not requires any parameter.
+// This function requires single optional parameter:
+//  policy ZipPolicy // ==> optional
+//                   //     description: decides what to do when the rows have different length.
+//                   //                  `ZipTruncate`, `ZipPad` or `ZipReturnError`.
+//                   //     default value: `ZipTruncate`
 //
 // Return values
 //
@@ -3613,8 +8787,8 @@ func (g *Chainable) SampleSize(take int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Shuffle() IChainable {
-	g.lastOperation = OperationShuffle
+func (g *Chainable) Unzip(policy ...ZipPolicy) IChainable {
+	g.lastOperation = OperationUnzip
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -3633,72 +8807,78 @@ func (g *Chainable) Shuffle() IChainable {
 			return nil
 		}
 
-		rand.Seed(time.Now().UnixNano())
+		zipPolicy := ZipTruncate
+		if len(policy) > 0 {
+			zipPolicy = policy[0]
+		}
 
-		n := dataValueLen
-		for i := n - 1; i > 0; i-- {
-			j := rand.Intn(i + 1)
+		rows := make([]reflect.Value, dataValueLen)
+		length := 0
+		for i := range rows {
+			rows[i] = indirectInterface(dataValue.Index(i))
+			if !rows[i].IsValid() || (rows[i].Kind() != reflect.Slice && rows[i].Kind() != reflect.Array) {
+				*err = fmt.Errorf("row %d should be slice", i)
+				return nil
+			}
 
-			iValue, jValue := dataValue.Index(i).Interface(), dataValue.Index(j).Interface()
-			dataValue.Index(i).Set(reflect.ValueOf(jValue))
-			dataValue.Index(j).Set(reflect.ValueOf(iValue))
-		}
+			if i == 0 {
+				length = rows[i].Len()
+				continue
+			}
 
-		return dataValue.Interface()
-	}(&err)
-	if err != nil {
-		return g.markError(result, err)
-	}
+			length = _zipLength(err, zipPolicy, length, rows[i].Len(), func() string {
+				return fmt.Sprintf("length of row %d should be same with length of row 0", i)
+			})
+			if *err != nil {
+				return nil
+			}
+		}
 
-	return g.markResult(result)
-}
+		columnTypes := make([]reflect.Type, length)
+		for j := range columnTypes {
+			for i := range rows {
+				if j >= rows[i].Len() {
+					continue
+				}
 
-// Size function gets the size of slice or struct object/map by returning its length for array-like values or the number of own enumerable string keyed properties for objects.
-//
-// Parameters
-//
-// This function does not requires any parameter.
-//
-// Return values
-//
-// Chain with these methods to get result:
-//  .Result() int                  // ==> description: returns the length of data
-//  .ResultAndError() (int, error) // ==> description: returns the length of data, and error object
-//  .Error() error                 // ==> description: returns error object
-//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
-//
-// Examples
-//
-// List of examples available:
-func (g *Chainable) Size() IChainable {
-	g.lastOperation = OperationSize
-	if g.IsError() || g.shouldReturn() {
-		return g
-	}
+				eachType := rows[i].Type().Elem()
+				if each := indirectInterface(rows[i].Index(j)); eachType.Kind() == reflect.Interface && each.IsValid() {
+					eachType = each.Type()
+				}
 
-	err := (error)(nil)
-	result := func(err *error) int {
-		defer catch(err)
+				if columnTypes[j] == nil {
+					columnTypes[j] = eachType
+				} else if columnTypes[j] != eachType {
+					columnTypes[j] = reflect.TypeOf((*interface{})(nil)).Elem()
+					break
+				}
+			}
 
-		if !isNonNilData(err, "data", g.data) {
-			return 0
+			if columnTypes[j] == nil {
+				columnTypes[j] = reflect.TypeOf((*interface{})(nil)).Elem()
+			}
 		}
 
-		dataValue, _, dataValueKind, dataValueLen := inspectData(g.data)
+		resultType := reflect.TypeOf([]interface{}{})
+		if elemType := dataValue.Type().Elem(); elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Array {
+			if elemType.Elem().Kind() != reflect.Interface {
+				resultType = reflect.SliceOf(reflect.SliceOf(elemType.Elem()))
+			}
+		}
 
-		if !isSlice(err, "data", dataValue) {
-			if dataValueKind == reflect.String {
-				*err = nil
-				return len(g.data.(string))
-			} else if dataValueKind == reflect.Map {
-				*err = nil
-				return dataValueLen
+		result := makeSlice(resultType, length, length)
+		for j := 0; j < length; j++ {
+			column := makeSlice(reflect.SliceOf(columnTypes[j]), dataValueLen, dataValueLen)
+			for i, row := range rows {
+				if each := indirectInterface(_zipElement(row, j)); each.IsValid() {
+					column.Index(i).Set(each)
+				}
 			}
 
-			return 0
+			result.Index(j).Set(column)
 		}
 
-		return dataValueLen
+		return result.Interface()
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -3707,63 +8887,60 @@ func (g *Chainable) Size() IChainable {
 	return g.markResult(result)
 }
 
-// Tail function gets all but the first element of `data`.
+// Variance function computes the population variance of numeric `data`.
 //
 // Parameters
 //
-// This function does not requires any parameter.
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() int                  // ==> description: returns the length of data
-//  .ResultAndError() (int, error) // ==> description: returns the length of data, and error object
-//  .Error() error                 // ==> description: returns error object
-//  .IsError() bool                // ==> description: return `true` on error, otherwise `false`
+//  .Result() float64                  // ==> description: returns the variance
+//  .ResultAndError() (float64, error) // ==> description: returns the variance, and error object
+//  .Error() error                     // ==> description: returns error object
+//  .IsError() bool                    // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Tail() IChainable {
-	g.lastOperation = OperationTail
+func (g *Chainable) Variance(args ...interface{}) IChainableFloatResult {
+	g.lastOperation = OperationVariance
 	if g.IsError() || g.shouldReturn() {
-		return g
+		return &resultVariance{chainable: g}
 	}
 
 	err := (error)(nil)
 	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
 			return nil
 		}
 
-		if dataValueLen == 0 {
-			return makeSlice(dataType).Interface()
-		}
-
-		result := dataValue.Slice(1, dataValueLen)
-		return result.Interface()
+		result, statsErr := stats.Variance(numbers)
+		*err = statsErr
+		return result
 	}(&err)
 	if err != nil {
-		return g.markError(result, err)
+		return &resultVariance{chainable: g.markError(result, err)}
 	}
 
-	return g.markResult(result)
+	return &resultVariance{chainable: g.markResult(result)}
 }
 
-// Take function creates a slice of `data` with `size` elements taken from the beginning.
+// Window function creates a slice of overlapping windows of `data`, each window has `size` elements, and the next window starts `step` elements after the previous one.
+// Only full windows are returned, so if `data` has less than `size` elements the result is empty.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  size int // ==> description: the length of each chunk
+// This function requires two mandatory parameters:
+//  size int // ==> description: the length of each window
+//  step int // ==> description: the distance between the start of two consecutive windows
 //
 // Return values
 //
@@ -3776,8 +8953,8 @@ func (g *Chainable) Tail() IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Take(size int) IChainable {
-	g.lastOperation = OperationTake
+func (g *Chainable) Window(size, step int) IChainable {
+	g.lastOperation = OperationWindow
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -3786,33 +8963,17 @@ func (g *Chainable) Take(size int) IChainable {
 	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
+		if size <= 0 {
+			*err = errors.New("size should be greater than zero")
 			return nil
 		}
 
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
+		if step <= 0 {
+			*err = errors.New("step should be greater than zero")
 			return nil
 		}
 
-		if !isZeroOrPositiveNumber(err, "size", size) {
-			return g.data
-		}
-
-		result := makeSlice(dataType)
-
-		if dataValueLen == 0 {
-			return result.Interface()
-		}
-
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			if i < size {
-				result = reflect.Append(result, each)
-			}
-		})
-
-		return result.Interface()
+		return _window(err, g.data, size, step)
 	}(&err)
 	if err != nil {
 		return g.markError(result, err)
@@ -3821,12 +8982,60 @@ func (g *Chainable) Take(size int) IChainable {
 	return g.markResult(result)
 }
 
-// TakeRight function creates a slice of `data` with `size` elements taken from the end.
+func _window(err *error, data interface{}, size, step int) interface{} {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	sliceType := reflect.SliceOf(dataValue.Type().Elem())
+	result := makeSlice(reflect.SliceOf(sliceType))
+
+	for start := 0; start+size <= dataValueLen; start += step {
+		window := makeSlice(sliceType, size, size)
+		for i := 0; i < size; i++ {
+			window.Index(i).Set(dataValue.Index(start + i))
+		}
+
+		result = reflect.Append(result, window)
+	}
+
+	return result.Interface()
+}
+
+// ZipPolicy decides what `Zip()`, `ZipWith()` and `Unzip()` do when the slices have different length
+type ZipPolicy int
+
+const (
+	// ZipTruncate stops at the end of the shortest slice. This is the default policy.
+	ZipTruncate ZipPolicy = iota
+
+	// ZipPad continues until the end of the longest slice, missing elements are filled with zero value
+	ZipPad
+
+	// ZipReturnError stops the operation with error
+	ZipReturnError
+)
+
+// Zip function groups the elements of `data` and the other slices by their index, e.g. to combine parallel columns into rows. Each row is slice of the i-th element of every slice.
+// The result is `[][]anyType` if all slices have the same element type, otherwise `[][]interface{}`.
 //
 // Parameters
 //
-// This function requires single mandatory parameter:
-//  size int // ==> description: the length of each chunk
+// This function requires optional variadic parameters:
+//  sliceToZip1 interface{} // ==> description: the slice to zip
+//  sliceToZip2 interface{} // ==> description: the slice to zip
+//  sliceToZip3 interface{} // ==> description: the slice to zip
+//  ...
+//  policy ZipPolicy        // ==> optional, as the last parameter
+//                          //     description: decides what to do when the slices have different length.
+//                          //                  `ZipTruncate`, `ZipPad` or `ZipReturnError`.
+//                          //     default value: `ZipTruncate`
 //
 // Return values
 //
@@ -3839,8 +9048,8 @@ func (g *Chainable) Take(size int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) TakeRight(size int) IChainable {
-	g.lastOperation = OperationTakeRight
+func (g *Chainable) Zip(slicesToZip ...interface{}) IChainable {
+	g.lastOperation = OperationZip
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
@@ -3849,32 +9058,29 @@ func (g *Chainable) TakeRight(size int) IChainable {
 	result := func(err *error) interface{} {
 		defer catch(err)
 
-		if !isNonNilData(err, "data", g.data) {
-			return nil
-		}
-
-		dataValue, dataType, _, dataValueLen := inspectData(g.data)
-
-		if !isSlice(err, "data", dataValue) {
+		slices, length := _zipInputs(err, g.data, slicesToZip)
+		if *err != nil {
 			return nil
 		}
 
-		if !isZeroOrPositiveNumber(err, "size", size) {
-			return g.data
+		rowType := reflect.SliceOf(slices[0].Type().Elem())
+		for _, slice := range slices[1:] {
+			if slice.Type().Elem() != rowType.Elem() {
+				rowType = reflect.TypeOf([]interface{}{})
+				break
+			}
 		}
 
-		result := makeSlice(dataType)
+		result := makeSlice(reflect.SliceOf(rowType), length, length)
+		for i := 0; i < length; i++ {
+			row := makeSlice(rowType, len(slices), len(slices))
+			for j, slice := range slices {
+				row.Index(j).Set(_zipElement(slice, i))
+			}
 
-		if dataValueLen == 0 {
-			return result.Interface()
+			result.Index(i).Set(row)
 		}
 
-		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-			if i >= (dataValueLen - size) {
-				result = reflect.Append(result, each)
-			}
-		})
-
 		return result.Interface()
 	}(&err)
 	if err != nil {
@@ -3884,11 +9090,100 @@ func (g *Chainable) TakeRight(size int) IChainable {
 	return g.markResult(result)
 }
 
-// Uniq create slice of unique values from it.
+// _zipInputs collects `data` and the other slices, and computes the length of the result based on the policy (which can be the last element of `others`)
+func _zipInputs(err *error, data interface{}, others []interface{}) ([]reflect.Value, int) {
+	policy := ZipTruncate
+	if len(others) > 0 {
+		if eachPolicy, ok := others[len(others)-1].(ZipPolicy); ok {
+			policy = eachPolicy
+			others = others[:len(others)-1]
+		}
+	}
+
+	if !isNonNilData(err, "data", data) {
+		return nil, 0
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil, 0
+	}
+
+	slices := []reflect.Value{dataValue}
+	length := dataValueLen
+
+	for i, eachData := range others {
+		eachLabel := fmt.Sprintf("zip data %d", i+1)
+
+		if !isNonNilData(err, eachLabel, eachData) {
+			return nil, 0
+		}
+
+		eachValue, _, _, eachValueLen := inspectData(eachData)
+
+		if !isSlice(err, eachLabel, eachValue) {
+			return nil, 0
+		}
+
+		length = _zipLength(err, policy, length, eachValueLen, func() string {
+			return fmt.Sprintf("length of %s should be same with length of data", eachLabel)
+		})
+		if *err != nil {
+			return nil, 0
+		}
+
+		slices = append(slices, eachValue)
+	}
+
+	return slices, length
+}
+
+func _zipLength(err *error, policy ZipPolicy, length, eachLength int, message func() string) int {
+	switch policy {
+	case ZipPad:
+		if eachLength > length {
+			return eachLength
+		}
+	case ZipReturnError:
+		if eachLength != length {
+			*err = errors.New(message())
+		}
+	default:
+		if eachLength < length {
+			return eachLength
+		}
+	}
+
+	return length
+}
+
+// _zipElement gets the i-th element of `slice`, or zero value if `slice` is shorter
+func _zipElement(slice reflect.Value, i int) reflect.Value {
+	if i < slice.Len() {
+		return slice.Index(i)
+	}
+
+	return reflect.Zero(slice.Type().Elem())
+}
+
+// ZipWith function groups the elements of `data` and the other slices by their index, and combines each group using constructor, e.g. to build struct from parallel columns.
+// The result is slice with the same element type as the constructor return value.
 //
 // Parameters
 //
-// This function does not requires any parameter.
+// This function requires one mandatory parameter, and optional variadic parameters:
+//  constructor interface{} // ==> type: `func(each1 anyType, each2 anyType, ...)<any type>`
+//                          // ==> description: the function to combine the i-th element of `data` and every other slice.
+//                          //                  the number of parameters should be the number of slices, including `data`.
+//  sliceToZip1 interface{} // ==> description: the slice to zip
+//  sliceToZip2 interface{} // ==> description: the slice to zip
+//  sliceToZip3 interface{} // ==> description: the slice to zip
+//  ...
+//  policy ZipPolicy        // ==> optional, as the last parameter
+//                          //     description: decides what to do when the slices have different length.
+//                          //                  `ZipTruncate`, `ZipPad` or `ZipReturnError`.
+//                          //     default value: `ZipTruncate`
 //
 // Return values
 //
@@ -3901,14 +9196,43 @@ func (g *Chainable) TakeRight(size int) IChainable {
 // Examples
 //
 // List of examples available:
-func (g *Chainable) Uniq() IChainable {
-	g.lastOperation = OperationUniq
+func (g *Chainable) ZipWith(constructor interface{}, slicesToZip ...interface{}) IChainable {
+	g.lastOperation = OperationZipWith
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := _union(&err, g.data)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		slices, length := _zipInputs(err, g.data, slicesToZip)
+		if *err != nil {
+			return nil
+		}
+
+		inputTypes := make([]reflect.Type, len(slices))
+		for i, slice := range slices {
+			inputTypes[i] = slice.Type().Elem()
+		}
+
+		constructorValue := inspectFuncWithInputs(err, "constructor", constructor, inputTypes...)
+		if *err != nil {
+			return nil
+		}
+
+		result := makeSlice(reflect.SliceOf(constructorValue.Type().Out(0)), length, length)
+		for i := 0; i < length; i++ {
+			args := make([]reflect.Value, len(slices))
+			for j, slice := range slices {
+				args[j] = _zipElement(slice, i)
+			}
+
+			result.Index(i).Set(constructorValue.Call(args)[0])
+		}
+
+		return result.Interface()
+	}(&err)
 	if err != nil {
 		return g.markError(result, err)
 	}
@@ -3916,98 +9240,48 @@ func (g *Chainable) Uniq() IChainable {
 	return g.markResult(result)
 }
 
-// Union function combines all slices presented on the parameters, then create slice of unique values from it. All slice must have same data type.
+// ZScores function computes the standard score of each element of numeric `data`, i.e. how many population standard deviations it is from the mean. The result is `[]float64`.
 //
 // Parameters
 //
-// This function requires optional variadic parameters:
-//  sliceToUnion1 interface{} // ==> description: the index of item to exclude
-//  sliceToUnion2 interface{} // ==> description: the index of item to exclude
-//  sliceToUnion3 interface{} // ==> description: the index of item to exclude
-//  ...
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
 //
 // Return values
 //
 // Chain with these methods to get result:
-//  .Result() interface{}                  // ==> description: returns the result after operation
-//  .ResultAndError() (interface{}, error) // ==> description: returns the result after operation, and error object
+//  .Result() interface{}                  // ==> description: returns `[]float64`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `[]float64`, and error object
 //  .Error() error                         // ==> description: returns error object
 //  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
 //
 // Examples
 //
 // List of examples available:
-func (g *Chainable) UnionMany(sliceToUnion ...interface{}) IChainable {
-	g.lastOperation = OperationUnionMany
+func (g *Chainable) ZScores(args ...interface{}) IChainable {
+	g.lastOperation = OperationZScores
 	if g.IsError() || g.shouldReturn() {
 		return g
 	}
 
 	err := (error)(nil)
-	result := _union(&err, g.data, sliceToUnion...)
-	if err != nil {
-		return g.markError(result, err)
-	}
-
-	return g.markResult(result)
-}
-
-func _union(err *error, data interface{}, slices ...interface{}) interface{} {
-	defer catchWithCustomErrorMessage(err, func(errorMessage string) string {
-		if strings.Contains(errorMessage, "is not assignable") {
-			return "data type of each elements between slice must be same"
-		}
-
-		return errorMessage
-	})
-
-	if !isNonNilData(err, "data", data) {
-		return nil
-	}
-
-	dataValue, dataType, _, dataValueLen := inspectData(data)
-
-	if !isSlice(err, "data", dataValue) {
-		return nil
-	}
-
-	result := makeSlice(dataType)
-	resultMap := make(map[interface{}]bool, 0)
-
-	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
-		eachRealValue := each.Interface()
-
-		if _, ok := resultMap[eachRealValue]; !ok {
-			resultMap[eachRealValue] = true
-			result = reflect.Append(result, each)
-		}
-	})
-
-	for _, each := range slices {
-		targetValue, _, _, targetValueLen := inspectData(each)
+	result := func(err *error) interface{} {
+		defer catch(err)
 
-		if !isSlice(err, "data", targetValue) {
+		numbers := _statsNumbers(err, g.data, args)
+		if *err != nil {
 			return nil
 		}
 
-		if targetValueLen > 0 {
-
-			forEachSliceStoppable(targetValue, targetValueLen, func(inner reflect.Value, j int) bool {
-				if *err != nil {
-					return false
-				}
-
-				targetEachRealValue := inner.Interface()
-
-				if _, ok := resultMap[targetEachRealValue]; !ok {
-					resultMap[targetEachRealValue] = true
-					result = reflect.Append(result, inner)
-				}
-
-				return true
-			})
-		}
+		result, statsErr := stats.ZScores(numbers)
+		*err = statsErr
+		return result
+	}(&err)
+	if err != nil {
+		return g.markError(result, err)
 	}
 
-	return result.Interface()
+	return g.markResult(result)
 }
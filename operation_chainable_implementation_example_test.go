@@ -3,9 +3,23 @@ package gubrak
 import (
 	"fmt"
 	"log"
+	"math"
 	"strings"
+	"time"
 )
 
+func ExampleChainable_Bucketize_bucketize1() {
+	data := []float64{5, 25, 120, 80, 300}
+
+	result := From(data).Bucketize([]float64{50, 100}, nil).Result()
+	for _, bucket := range result.([]Bucket) {
+		fmt.Println(bucket.Lower, bucket.Upper, bucket.Items)
+	}
+	// ===> -Inf 50 [5 25]
+	// ===> 50 100 [80]
+	// ===> 100 +Inf [120 300]
+}
+
 func ExampleChainable_Chunk_chunk1() {
 	data := []int{1, 2, 3, 4, 5}
 	size := 2
@@ -44,6 +58,40 @@ func ExampleChainable_Chunk_chunk3() {
 	*/
 }
 
+func ExampleChainable_ChunkByWeight_chunkByWeight1() {
+	data := []string{"lorem", "ipsum", "dolor", "sit", "amet"}
+
+	result := From(data).
+		ChunkByWeight(10, func(each string) int {
+			return len(each)
+		}, OversizeOwnChunk).
+		Result()
+
+	fmt.Println(result)
+	// ===> [][]string{ { "lorem", "ipsum" }, { "dolor", "sit" }, { "amet" } }
+}
+
+func ExampleChainable_Window_window1() {
+	data := []int{1, 2, 3, 4, 5}
+
+	result := From(data).Window(3, 2).Result()
+	fmt.Println(result)
+	// ===> [][]int{ { 1, 2, 3 }, { 3, 4, 5 } }
+}
+
+func ExampleChainable_ChunkWhile_chunkWhile1() {
+	data := []int{1, 2, 4, 9, 10, 11, 12, 15}
+
+	result := From(data).
+		ChunkWhile(func(previous, current int) bool {
+			return current == previous+1
+		}).
+		Result()
+
+	fmt.Println(result)
+	// ===> [][]int{ { 1, 2 }, { 4 }, { 9, 10, 11, 12 }, { 15 } }
+}
+
 func ExampleChainable_Compact_compact1() {
 	data := []int{-2, -1, 0, 1, 2}
 
@@ -247,6 +295,19 @@ func ExampleChainable_DropRight_dropRight2() {
 	// ===> []string{ "a", "b", "c" }
 }
 
+func ExampleChainable_DropWhile_dropWhile1() {
+	data := []int{1, 2, 3, 4, 1, 2}
+
+	result := From(data).
+		DropWhile(func(each int) bool {
+			return each < 3
+		}).
+		Result()
+
+	fmt.Println(result)
+	// ===> []int{ 3, 4, 1, 2 }
+}
+
 func ExampleChainable_Each_eachMap1() {
 	data := map[string]interface{}{
 		"name":   "damian",
@@ -789,6 +850,80 @@ func ExampleChainable_FromPairs_fromPairs2() {
 	*/
 }
 
+func ExampleChainable_Flatten_flatten1() {
+	data := []int{1, 2, 3, 4, 5}
+
+	evens, odds, _ := From(data).
+		Partition(func(each int) bool {
+			return each%2 == 0
+		}).
+		ResultAndError()
+
+	flattened := From([][]int{evens.([]int), odds.([]int)}).Flatten().Result()
+	fmt.Println(flattened)
+	// ===> []int{ 2, 4, 1, 3, 5 }
+}
+
+func ExampleChainable_FlattenDeep_flattenDeep1() {
+	data := []interface{}{1, []interface{}{2, []int{3, 4}}, "five"}
+
+	result := From(data).FlattenDeep().Result()
+	fmt.Println(result)
+	// ===> []interface{}{ 1, 2, 3, 4, "five" }
+}
+
+func ExampleChainable_Frequencies_frequencies1() {
+	data := []string{"a", "b", "a", "c", "a", "b"}
+
+	result := From(data).Frequencies().Result()
+	fmt.Println(result)
+	// ===> map[string]int{ "a": 3, "b": 2, "c": 1 }
+}
+
+func ExampleChainable_TopK_topK1() {
+	type Player struct {
+		Name  string
+		Score int
+	}
+
+	data := []Player{
+		{Name: "damian", Score: 10},
+		{Name: "grayson", Score: 50},
+		{Name: "jason", Score: 30},
+		{Name: "tim", Score: 40},
+	}
+
+	result := From(data).
+		TopK(2, func(each Player) int {
+			return each.Score
+		}).
+		Result()
+
+	fmt.Println(result)
+	// ===> []main.Player{ { Name: "grayson", Score: 50 }, { Name: "tim", Score: 40 } }
+}
+
+func ExampleChainable_Sum_sum1() {
+	data := []int64{math.MaxInt64, 1, -2}
+
+	result, ok := From(data).Sum().Int()
+	fmt.Println(result, ok)
+	// ===> 9223372036854775806 true
+}
+
+func ExampleChainable_MinBy_minBy1() {
+	data := []string{"pear", "fig", "kiwi", "yam"}
+
+	result, index, _ := From(data).
+		MinBy(func(each string) int {
+			return len(each)
+		}).
+		ResultAndError()
+
+	fmt.Println(result, index)
+	// ===> fig 1
+}
+
 func ExampleChainable_GroupBy_groupBy1() {
 	type Sample struct {
 		Ebook    string
@@ -851,6 +986,32 @@ func ExampleChainable_GroupBy_groupBy2() {
 	*/
 }
 
+func ExampleChainable_GroupBy_groupBy3() {
+	type Sale struct {
+		Region string
+		Amount int
+	}
+
+	data := []Sale{
+		{Region: "east", Amount: 3},
+		{Region: "west", Amount: 5},
+		{Region: "east", Amount: 7},
+	}
+
+	result := From(data).
+		GroupBy(func(each Sale) string {
+			return each.Region
+		}).
+		Sum(func(each Sale) int {
+			return each.Amount
+		}).
+		Rows().
+		Result()
+
+	fmt.Println(result)
+	// ===> []KeyValue{ { Key: "east", Value: 10 }, { Key: "west", Value: 5 } }
+}
+
 func ExampleChainable_Contains_containsMap1() {
 	data := map[string]string{
 		"name":  "grayson",
@@ -914,6 +1075,59 @@ func ExampleChainable_Contains_containsSlice5() {
 	From("damian").Contains("an").Result() // ===> true
 }
 
+func ExampleChainable_GroupByMany_groupByMany1() {
+	type Sale struct {
+		Region string
+		Month  int
+		Amount int
+	}
+
+	data := []Sale{
+		{Region: "west", Month: 2, Amount: 10},
+		{Region: "east", Month: 1, Amount: 20},
+		{Region: "west", Month: 1, Amount: 30},
+	}
+
+	result := From(data).
+		GroupByMany(func(each Sale) string {
+			return each.Region
+		}, func(each Sale) int {
+			return each.Month
+		}).
+		Result()
+
+	fmt.Println(result)
+	/*
+		  map[string]map[int][]main.Sale {
+			"west": map[int][]main.Sale {
+			  2: []main.Sale{ { Region: "west", Month: 2, Amount: 10 } },
+			  1: []main.Sale{ { Region: "west", Month: 1, Amount: 30 } },
+			},
+			"east": map[int][]main.Sale {
+			  1: []main.Sale{ { Region: "east", Month: 1, Amount: 20 } },
+			},
+		  }
+	*/
+}
+
+func ExampleChainable_GroupByOrdered_groupByOrdered1() {
+	data := []string{"banana", "apple", "blueberry", "cherry", "avocado"}
+
+	result := From(data).
+		GroupByOrdered(func(each string) string {
+			return each[:1]
+		}).
+		Result()
+
+	result.(*OrderedMap).Each(func(key, value interface{}) bool {
+		fmt.Println(key, value)
+		return true
+	})
+	// ===> b [banana blueberry]
+	// ===> a [apple avocado]
+	// ===> c [cherry]
+}
+
 func ExampleChainable_IndexOf_indexOf1() {
 	data := []string{"damian", "grayson", "cass", "tim", "tim", "jason", "steph"}
 
@@ -978,6 +1192,34 @@ func ExampleChainable_Initial_initial4() {
 	// ===> []float64{}
 }
 
+func ExampleChainable_InnerJoin_innerJoin1() {
+	type Order struct {
+		ID         int
+		CustomerID int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{{ID: 1, CustomerID: 10}, {ID: 2, CustomerID: 20}, {ID: 3, CustomerID: 10}, {ID: 4, CustomerID: 99}}
+	customers := []Customer{{ID: 10, Name: "alice"}, {ID: 20, Name: "bob"}}
+
+	result := From(orders).
+		InnerJoin(customers, func(each Order) int {
+			return each.CustomerID
+		}, func(each Customer) int {
+			return each.ID
+		}, func(order Order, customer Customer) string {
+			return fmt.Sprintf("order %d by %s", order.ID, customer.Name)
+		}).
+		Result()
+
+	fmt.Println(result)
+	// ===> []string{ "order 1 by alice", "order 2 by bob", "order 3 by alice" }
+}
+
 func ExampleChainable_Intersection_intersection1() {
 	result := From([]string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}).
 		Intersection([]string{"cassandra", "tim", "jason"}).
@@ -1001,6 +1243,24 @@ func ExampleChainable_Intersection_intersection2() {
 	// ===> []float64{ 0.8001, 0.999, 1.33 }
 }
 
+func ExampleChainable_Interleave_interleave1() {
+	result := From([]string{"damian", "grayson", "tim"}).
+		Interleave([]string{"jason", "cassandra"}).
+		Result()
+
+	fmt.Println(result)
+	// ===> []string{ "damian", "jason", "grayson", "cassandra", "tim" }
+}
+
+func ExampleChainable_Intersperse_intersperse1() {
+	result := From([]string{"damian", "grayson", "tim"}).
+		Intersperse("and").
+		Result()
+
+	fmt.Println(result)
+	// ===> []string{ "damian", "and", "grayson", "and", "tim" }
+}
+
 func ExampleChainable_Join_join1() {
 	data := []string{"damian", "grayson", "cassandra"}
 	separator := " - "
@@ -1048,6 +1308,83 @@ func ExampleChainable_KeyBy_keyBy() {
 	*/
 }
 
+func ExampleChainable_KeyBy_keyByErrorOnDuplicate() {
+	type User struct {
+		Email string
+		Name  string
+	}
+
+	data := []User{
+		{Email: "jason@wayne.com", Name: "jason"},
+		{Email: "tim@wayne.com", Name: "tim"},
+		{Email: "jason@wayne.com", Name: "red hood"},
+	}
+
+	_, err := From(data).
+		KeyBy(func(each User) string {
+			return each.Email
+		}, KeyByErrorOnDuplicate).
+		ResultAndError()
+
+	if duplicateErr, ok := err.(*DuplicateKeyError); ok {
+		fmt.Println(duplicateErr.Key, duplicateErr.FirstIndex, duplicateErr.SecondIndex)
+		// ===> jason@wayne.com 0 2
+	}
+}
+
+func ExampleChainable_KeyBy_keyByMerge() {
+	type Stock struct {
+		SKU      string
+		Quantity int
+	}
+
+	data := []Stock{{SKU: "a", Quantity: 1}, {SKU: "b", Quantity: 2}, {SKU: "a", Quantity: 3}}
+
+	result := From(data).
+		KeyBy(func(each Stock) string {
+			return each.SKU
+		}, KeyByMerge(func(existing, each Stock) Stock {
+			existing.Quantity += each.Quantity
+			return existing
+		})).
+		Result()
+
+	fmt.Println(result)
+	// ===> map[string]main.Stock{ "a": { SKU: "a", Quantity: 4 }, "b": { SKU: "b", Quantity: 2 } }
+}
+
+func ExampleChainable_LeftJoin_leftJoin1() {
+	type Order struct {
+		ID         int
+		CustomerID int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{{ID: 1, CustomerID: 10}, {ID: 2, CustomerID: 99}}
+	customers := []Customer{{ID: 10, Name: "alice"}}
+
+	result := From(orders).
+		LeftJoin(customers, func(each Order) int {
+			return each.CustomerID
+		}, func(each Customer) int {
+			return each.ID
+		}, func(order Order, customer *Customer) string {
+			if customer == nil {
+				return fmt.Sprintf("order %d by unknown customer", order.ID)
+			}
+
+			return fmt.Sprintf("order %d by %s", order.ID, customer.Name)
+		}).
+		Result()
+
+	fmt.Println(result)
+	// ===> []string{ "order 1 by alice", "order 2 by unknown customer" }
+}
+
 func ExampleChainable_Last_last1() {
 	data := []string{"damian", "grayson", "cassandra"}
 
@@ -1333,6 +1670,41 @@ func ExampleChainable_Partition_partition() {
 	*/
 }
 
+func ExampleChainable_Classify_classify() {
+	type Order struct {
+		ID     int
+		Status string
+		Amount int
+	}
+
+	data := []Order{
+		{1, "paid", 120},
+		{2, "failed", 80},
+		{3, "paid", 5000},
+		{4, "pending", 60},
+		{5, "failed", 7000},
+	}
+
+	classes := From(data).
+		Classify(func(each Order) bool {
+			return each.Amount >= 1000
+		}, func(each Order) bool {
+			return each.Status == "failed"
+		})
+	if classes.IsError() {
+		log.Fatal(classes.Error().Error())
+	}
+
+	fmt.Printf("%v \n", classes.ResultAt(0))
+	// ===> [{3 paid 5000} {5 failed 7000}]
+
+	fmt.Printf("%v \n", classes.ResultAt(1))
+	// ===> [{2 failed 80}]
+
+	fmt.Printf("%v \n", classes.ResultRemainder())
+	// ===> [{1 paid 120} {4 pending 60}]
+}
+
 func ExampleChainable_Exclude_exclude1() {
 	data := []int{1, 2, 3, 4, 5, 6}
 	result := From(data).Exclude(3).Result()
@@ -1439,6 +1811,41 @@ func ExampleChainable_Reduce_reduceSlice2() {
 	*/
 }
 
+func ExampleChainable_Reduce_reduceSlice3() {
+	data := []int{5, 10, 20, 40, 80}
+
+	// stop summing once the budget is exceeded
+	result := From(data).
+		Reduce(func(accumulator, each int) (int, error) {
+			if accumulator+each > 50 {
+				return accumulator, ErrStopIteration
+			}
+
+			return accumulator + each, nil
+		}, 0).
+		Result()
+
+	fmt.Println(result)
+	// ===> 35
+}
+
+func ExampleChainable_ReduceRight_reduceRight1() {
+	data := []string{"damian", "grayson", "tim"}
+
+	result := From(data).
+		ReduceRight(func(accumulator string, each string, i int) string {
+			if accumulator == "" {
+				return each
+			}
+
+			return accumulator + ", " + each
+		}, "").
+		Result()
+
+	fmt.Println(result)
+	// ===> "tim, grayson, damian"
+}
+
 func ExampleChainable_Reject_rejectMap() {
 	data := map[string]int{
 		"clean code":       10000,
@@ -1494,6 +1901,45 @@ func ExampleChainable_Reverse_reverse2() {
 	// ===> []int{ 9, 8, 7, 6, 5, 4, 3, 2, 1 }
 }
 
+func ExampleChainable_RollingBy_rollingBy1() {
+	type Metric struct {
+		At        time.Time
+		LatencyMs float64
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	data := []Metric{
+		{At: start, LatencyMs: 100},
+		{At: start.Add(30 * time.Second), LatencyMs: 200},
+		{At: start.Add(90 * time.Second), LatencyMs: 600},
+	}
+
+	result := From(data).
+		RollingBy(time.Minute, func(each Metric) time.Time {
+			return each.At
+		}, func(window []Metric) float64 {
+			sum := 0.0
+			for _, each := range window {
+				sum += each.LatencyMs
+			}
+
+			return sum / float64(len(window))
+		}).
+		Result()
+
+	fmt.Println(result)
+	// ===> []float64{ 100, 150, 600 }
+}
+
+func ExampleChainable_Rotate_rotate1() {
+	result := From([]int{1, 2, 3, 4, 5}).
+		Rotate(-1).
+		Result()
+
+	fmt.Println(result)
+	// ===> []int{ 5, 1, 2, 3, 4 }
+}
+
 func ExampleChainable_Sample_sample() {
 	type Book struct {
 		EbookName      string
@@ -1557,6 +2003,19 @@ func ExampleChainable_SampleSize_sampleSize() {
 	*/
 }
 
+func ExampleChainable_Scan_scan1() {
+	data := []int{3, 1, 4, 1, 5}
+
+	result := From(data).
+		Scan(func(accumulator, each int) int {
+			return accumulator + each
+		}, 0).
+		Result()
+
+	fmt.Println(result)
+	// ===> []int{ 3, 4, 8, 9, 14 }
+}
+
 func ExampleChainable_Shuffle_shuffle1() {
 	data := []int{1, 2, 3, 4}
 	result := From(data).Shuffle().Result()
@@ -1692,6 +2151,33 @@ func ExampleChainable_TakeRight_takeRight2() {
 	// ===> []int{ 5, 6, 7, 8, 9 }
 }
 
+func ExampleChainable_TakeWhile_takeWhile1() {
+	data := []string{"jason", "damian", "grayson", "tim"}
+
+	result := From(data).
+		TakeWhile(func(each string, i int) bool {
+			return len(each) <= 6
+		}).
+		Result()
+
+	fmt.Println(result)
+	// ===> []string{ "jason", "damian" }
+}
+
+func ExampleChainable_Transpose_transpose1() {
+	data := [][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+
+	result := From(data).
+		Transpose().
+		Result()
+
+	fmt.Println(result)
+	// ===> [][]int{ {1, 4}, {2, 5}, {3, 6} }
+}
+
 func ExampleChainable_UnionMany_unionMany1() {
 	result := From([]string{"damian", "grayson", "grayson", "cassandra"}).
 		UnionMany(
@@ -1771,3 +2257,22 @@ func ExampleChainable_Uniq_uniq2() {
 // 	fmt.Println(result)
 // 	// ===> []string{ "damian", "cassandra", "jason", "stephanie" }
 // }
+
+func ExampleChainable_ZipWith_zipWith1() {
+	type Row struct {
+		City        string
+		Temperature float64
+	}
+
+	cities := []string{"jakarta", "bandung", "surabaya"}
+	temperatures := []float64{31.5, 24, 33.2}
+
+	result := From(cities).
+		ZipWith(func(city string, temperature float64) Row {
+			return Row{City: city, Temperature: temperature}
+		}, temperatures, ZipReturnError).
+		Result()
+
+	fmt.Println(result)
+	// ===> []main.Row{ { City: "jakarta", Temperature: 31.5 }, { City: "bandung", Temperature: 24 }, { City: "surabaya", Temperature: 33.2 } }
+}
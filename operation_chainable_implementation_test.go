@@ -3,12 +3,254 @@ package gubrak
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
+func TestAntiJoin(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	result, err := From(orders).
+		AntiJoin(customers, orderKey, customerKey).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Order{{ID: 4, CustomerID: 99, Amount: 400}}, result)
+}
+
+func TestAntiJoinKeyTypeMismatch(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	result, err := From(orders).
+		AntiJoin(customers, orderKey, func(each Customer) string { return each.Name }).
+		ResultAndError()
+
+	assert.EqualError(t, err, "type of left key should be same with type of right key")
+	assert.Nil(t, result)
+}
+
+func TestAntiJoinNilDataToJoin(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	_, err := From(orders).AntiJoin(nil, orderKey, customerKey).ResultAndError()
+
+	assert.EqualError(t, err, "data to join cannot be nil")
+}
+
+func TestBinByTime(t *testing.T) {
+	type Event struct {
+		Name string
+		At   time.Time
+	}
+
+	base := time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC)
+	data := []Event{
+		{Name: "c", At: base.Add(75 * time.Minute)},
+		{Name: "a", At: base.Add(5 * time.Minute)},
+		{Name: "b", At: base.Add(59 * time.Minute)},
+		{Name: "d", At: base.Add(-time.Second)},
+	}
+
+	result, err := From(data).
+		BinByTime(time.Hour, func(each Event) time.Time {
+			return each.At
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []TimeBucket{
+		{Start: base.Add(-time.Hour), End: base, Items: []Event{data[3]}},
+		{Start: base, End: base.Add(time.Hour), Items: []Event{data[1], data[2]}},
+		{Start: base.Add(time.Hour), End: base.Add(2 * time.Hour), Items: []Event{data[0]}},
+	}, result)
+}
+
+func TestBinByTimeLocalMidnight(t *testing.T) {
+	location := time.FixedZone("IST", 5*60*60+30*60)
+	data := []time.Time{
+		time.Date(2020, 3, 1, 0, 10, 0, 0, location),
+		time.Date(2020, 3, 1, 23, 50, 0, 0, location),
+		time.Date(2020, 3, 2, 1, 0, 0, 0, location),
+	}
+
+	result, err := From(data).BinByTime(24*time.Hour, nil).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []TimeBucket{
+		{Start: time.Date(2020, 3, 1, 0, 0, 0, 0, location), End: time.Date(2020, 3, 2, 0, 0, 0, 0, location), Items: data[:2]},
+		{Start: time.Date(2020, 3, 2, 0, 0, 0, 0, location), End: time.Date(2020, 3, 3, 0, 0, 0, 0, location), Items: data[2:]},
+	}, result)
+}
+
+func TestBinByTimeDaylightSaving(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database is not available")
+	}
+
+	data := []time.Time{
+		time.Date(2020, 3, 8, 1, 30, 0, 0, location),
+		time.Date(2020, 3, 8, 23, 30, 0, 0, location),
+	}
+
+	result, err := From(data).BinByTime(24*time.Hour, nil).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []TimeBucket{
+		{Start: time.Date(2020, 3, 8, 0, 0, 0, 0, location), End: time.Date(2020, 3, 9, 0, 0, 0, 0, location), Items: data},
+	}, result)
+	assert.EqualValues(t, 23*time.Hour, result.([]TimeBucket)[0].End.Sub(result.([]TimeBucket)[0].Start))
+}
+
+func TestBinByTimeInvalidInterval(t *testing.T) {
+	_, err := From([]time.Time{time.Now()}).BinByTime(0, nil).ResultAndError()
+
+	assert.EqualError(t, err, "interval should be greater than zero")
+}
+
+func TestBinByTimeInvalidKey(t *testing.T) {
+	_, err := From([]int{1}).BinByTime(time.Hour, nil).ResultAndError()
+
+	assert.EqualError(t, err, "key should be time.Time, got 1")
+}
+
+func TestBucketize(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	data := []Person{
+		{Name: "damian", Age: 13},
+		{Name: "grayson", Age: 30},
+		{Name: "jason", Age: 18},
+		{Name: "bruce", Age: 65},
+	}
+
+	result, err := From(data).
+		Bucketize([]float64{18, 40, 60}, func(each Person) int {
+			return each.Age
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Bucket{
+		{Lower: math.Inf(-1), Upper: 18, Items: []Person{data[0]}},
+		{Lower: 18, Upper: 40, Items: []Person{data[1], data[2]}},
+		{Lower: 40, Upper: 60, Items: []Person{}},
+		{Lower: 60, Upper: math.Inf(1), Items: []Person{data[3]}},
+	}, result)
+}
+
+func TestBucketizeWithoutKeyFn(t *testing.T) {
+	result, err := From([]float64{0.5, 1, 1.5, -2}).Bucketize([]float64{1}, nil).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Bucket{
+		{Lower: math.Inf(-1), Upper: 1, Items: []float64{0.5, -2}},
+		{Lower: 1, Upper: math.Inf(1), Items: []float64{1, 1.5}},
+	}, result)
+}
+
+func TestBucketizeInvalidEdges(t *testing.T) {
+	_, err := From([]int{1}).Bucketize([]float64{}, nil).ResultAndError()
+	assert.EqualError(t, err, "edges cannot be empty")
+
+	_, err = From([]int{1}).Bucketize([]float64{2, 1}, nil).ResultAndError()
+	assert.EqualError(t, err, "edges should be in strictly increasing order")
+}
+
+func TestBucketizeNotNumber(t *testing.T) {
+	_, err := From([]string{"a"}).Bucketize([]float64{1}, nil).ResultAndError()
+
+	assert.EqualError(t, err, "element data type should be number, or iteratee should be provided")
+}
+
 func TestChunkNegativeSize(t *testing.T) {
 	data := []string{"a", "b", "c", "d"}
 	size := -1
@@ -184,6 +426,95 @@ func TestChunkMapData(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestChunkByWeight(t *testing.T) {
+	result, err := From([]string{"aaa", "bb", "cccc", "d", "ee", "f"}).
+		ChunkByWeight(5, func(each string) int {
+			return len(each)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{{"aaa", "bb"}, {"cccc", "d"}, {"ee", "f"}}, result)
+}
+
+func TestChunkByWeightWithoutWeightFn(t *testing.T) {
+	result, err := From([]float64{0.5, 0.25, 0.5, 0, 1}).ChunkByWeight(1, nil).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]float64{{0.5, 0.25}, {0.5, 0}, {1}}, result)
+}
+
+func TestChunkByWeightOversizeReturnError(t *testing.T) {
+	_, err := From([]int{1, 7, 2}).ChunkByWeight(5, nil).ResultAndError()
+
+	assert.EqualError(t, err, "weight of element at index 1 is 7, which exceeds max weight 5")
+	oversizeError, ok := err.(*OversizeError)
+	assert.True(t, ok)
+	assert.EqualValues(t, &OversizeError{Index: 1, Weight: 7, MaxWeight: 5}, oversizeError)
+}
+
+func TestChunkByWeightOversizeOwnChunk(t *testing.T) {
+	result, err := From([]int{1, 2, 7, 2}).ChunkByWeight(5, nil, OversizeOwnChunk).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 2}, {7}, {2}}, result)
+}
+
+func TestChunkByWeightOversizeDrop(t *testing.T) {
+	result, err := From([]int{1, 2, 7, 2}).ChunkByWeight(5, nil, OversizeDrop).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 2, 2}}, result)
+}
+
+func TestChunkByWeightInvalid(t *testing.T) {
+	_, err := From([]int{1}).ChunkByWeight(0, nil).ResultAndError()
+	assert.EqualError(t, err, "max weight should be greater than zero")
+
+	_, err = From([]int{1, -1}).ChunkByWeight(5, nil).ResultAndError()
+	assert.EqualError(t, err, "weight of element at index 1 should not be negative")
+}
+
+func TestChunkWhile(t *testing.T) {
+	result, err := From([]int{1, 2, 4, 9, 10, 11, 12, 15}).
+		ChunkWhile(func(previous, current int) bool {
+			return current == previous+1
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 2}, {4}, {9, 10, 11, 12}, {15}}, result)
+}
+
+func TestChunkWhileEmptyData(t *testing.T) {
+	result, err := From([]string{}).
+		ChunkWhile(func(previous, current string) bool {
+			return true
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{}, result)
+}
+
+func TestChunkWhileInvalidPredicate(t *testing.T) {
+	_, err := From([]int{1}).
+		ChunkWhile(func(current int) bool {
+			return true
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "predicate parameter should be (int, int)")
+
+	_, err = From([]int{1}).
+		ChunkWhile(func(previous, current int) int {
+			return 0
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "predicate return value data type should be bool")
+}
+
 func TestCompactFewData(t *testing.T) {
 	var dataInterfaceNil interface{}
 	var dataInterface interface{} = "damian"
@@ -833,6 +1164,91 @@ func TestCountByMapWithPredicate1(t *testing.T) {
 	assert.EqualValues(t, 2, result)
 }
 
+func TestCountByOrdered(t *testing.T) {
+	data := []string{"pear", "apple", "plum", "avocado", "peach"}
+
+	result, err := From(data).
+		CountByOrdered(func(each string) string {
+			return each[:1]
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []KeyValue{{Key: "p", Value: 3}, {Key: "a", Value: 2}}, result.(*OrderedMap).Pairs())
+}
+
+func TestCountByKey(t *testing.T) {
+	data := []float64{6.1, 4.2, 6.3}
+
+	result, err := From(data).
+		CountByKey(func(each float64) int {
+			return int(each)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[int]int{6: 2, 4: 1}, result)
+}
+
+func TestCountByKeyWithIndex(t *testing.T) {
+	result, err := From([]string{"a", "b", "c", "d", "e"}).
+		CountByKey(func(each string, i int) bool {
+			return i%2 == 0
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[bool]int{true: 3, false: 2}, result)
+}
+
+func TestCountByKeyNonComparableKey(t *testing.T) {
+	_, err := From([]int{1, 2}).
+		CountByKey(func(each int) []int {
+			return []int{each}
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "key data type should be comparable")
+}
+
+func TestCumulativeSum(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4}).CumulativeSum().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{1, 3, 6, 10}, result)
+}
+
+func TestCumulativeSumWithIteratee(t *testing.T) {
+	type Metric struct {
+		At    time.Time
+		Value float64
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	data := []Metric{
+		{At: start, Value: 1},
+		{At: start.Add(time.Minute), Value: 2},
+		{At: start.Add(2 * time.Minute), Value: 4},
+		{At: start.Add(5 * time.Minute), Value: 8},
+	}
+
+	result, err := From(data).
+		CumulativeSum(func(each Metric) float64 {
+			return each.Value
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{1, 3, 7, 15}, result)
+}
+
+func TestCumulativeSumNotNumber(t *testing.T) {
+	_, err := From([]string{"a"}).CumulativeSum().ResultAndError()
+
+	assert.EqualError(t, err, "element data type should be number, or iteratee should be provided")
+}
+
 func TestDifferenceOneData(t *testing.T) {
 	data := []int{1, 2, 3, 4, 4, 6, 7}
 	diff := []int{2, 7}
@@ -1041,6 +1457,62 @@ func TestDropRightZeroSizeNegative(t *testing.T) {
 	assert.EqualValues(t, []int{1, 2, 3, 4, 4, 5, 6}, result)
 }
 
+func TestDropWhile(t *testing.T) {
+	data := []int{1, 2, 3, 4, 1, 2}
+	result, err := From(data).DropWhile(func(each int) bool {
+		return each < 3
+	}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{3, 4, 1, 2}, result)
+}
+
+func TestDropWhileStopsAtFirstFailingElement(t *testing.T) {
+	data := []int{1, 2, 3, 4, 1, 2}
+	visited := make([]int, 0)
+	result, err := From(data).DropWhile(func(each int, i int) bool {
+		visited = append(visited, i)
+		return each < 3
+	}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{3, 4, 1, 2}, result)
+	assert.EqualValues(t, []int{0, 1, 2}, visited)
+}
+
+func TestDropWhileAll(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra"}
+	result, err := From(data).DropWhile(func(each string) bool {
+		return true
+	}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{}, result)
+}
+
+func TestDropWhileInvalidPredicate(t *testing.T) {
+	data := []int{1, 2, 3}
+	_, err := From(data).DropWhile(func(each int) int {
+		return each
+	}).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "callback return value should be one variable with bool type")
+}
+
+func TestDropRightWhile(t *testing.T) {
+	data := []int{1, 2, 3, 4, 1, 2}
+	visited := make([]int, 0)
+	result, err := From(data).DropRightWhile(func(each int, i int) bool {
+		visited = append(visited, i)
+		return each < 3
+	}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2, 3, 4}, result)
+	assert.EqualValues(t, []int{5, 4, 3}, visited)
+}
+
 func TestEachSlice(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra"}
 	separator := ","
@@ -1287,28 +1759,97 @@ func TestFillWithNegativeLastIndex(t *testing.T) {
 	assert.EqualValues(t, []int{1, 2, 3, 4, 4, 5, 6}, result)
 }
 
-func TestFilterSlice(t *testing.T) {
-	type Sample struct {
-		EbookName      string
-		DailyDownloads int
+func TestFillForward(t *testing.T) {
+	type Metric struct {
+		At    time.Time
+		Value float64
 	}
 
-	data := []Sample{
-		{EbookName: "clean code", DailyDownloads: 10000},
-		{EbookName: "rework", DailyDownloads: 12000},
-		{EbookName: "detective comics", DailyDownloads: 11500},
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	data := []Metric{
+		{At: start, Value: -1},
+		{At: start.Add(time.Minute), Value: 3},
+		{At: start.Add(2 * time.Minute), Value: -1},
+		{At: start.Add(3 * time.Minute), Value: -1},
+		{At: start.Add(4 * time.Minute), Value: 5},
 	}
 
-	err := From(data).
-		Filter(func(each Sample) bool {
-			return each.DailyDownloads > 11000
+	result, err := From(data).
+		FillForward(func(each Metric) bool {
+			return each.Value < 0
+		}, func(missing, source Metric) Metric {
+			missing.Value = source.Value
+			return missing
 		}).
-		Each(func(each Sample) {
-			if each.EbookName == "clean code" {
-				t.Fail()
-			}
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Metric{
+		{At: start, Value: -1},
+		{At: start.Add(time.Minute), Value: 3},
+		{At: start.Add(2 * time.Minute), Value: 3},
+		{At: start.Add(3 * time.Minute), Value: 3},
+		{At: start.Add(4 * time.Minute), Value: 5},
+	}, result)
+	assert.EqualValues(t, -1, data[2].Value)
+}
+
+func TestFillBackward(t *testing.T) {
+	result, err := From([]int{0, 0, 2, 0, 4, 0}).
+		FillBackward(func(each int) bool {
+			return each == 0
 		}).
-		Error()
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{2, 2, 2, 4, 4, 0}, result)
+}
+
+func TestFillForwardInvalidPredicate(t *testing.T) {
+	_, err := From([]int{1}).
+		FillForward(func(each int) int {
+			return each
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "isMissing return value data type should be bool")
+}
+
+func TestFillForwardInvalidFiller(t *testing.T) {
+	_, err := From([]int{1}).
+		FillForward(func(each int) bool {
+			return false
+		}, func(missing int) int {
+			return missing
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "filler parameter should be (int, int)")
+}
+
+func TestFilterSlice(t *testing.T) {
+	type Sample struct {
+		EbookName      string
+		DailyDownloads int
+	}
+
+	data := []Sample{
+		{EbookName: "clean code", DailyDownloads: 10000},
+		{EbookName: "rework", DailyDownloads: 12000},
+		{EbookName: "detective comics", DailyDownloads: 11500},
+	}
+
+	err := From(data).
+		Filter(func(each Sample) bool {
+			return each.DailyDownloads > 11000
+		}).
+		Each(func(each Sample) {
+			if each.EbookName == "clean code" {
+				t.Fail()
+			}
+		}).
+		Error()
 
 	assert.Nil(t, err)
 }
@@ -1715,6 +2256,127 @@ func TestFirstWithNilData(t *testing.T) {
 	assert.Equal(t, nil, result)
 }
 
+func TestFlatMap(t *testing.T) {
+	result, err := From([]string{"a b", "c", ""}).
+		FlatMap(func(each string) []string {
+			return strings.Fields(each)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"a", "b", "c"}, result)
+}
+
+func TestFlatMapWithIndex(t *testing.T) {
+	result, err := From([]int{5, 7}).
+		FlatMap(func(each int, i int) []int {
+			return []int{i, each}
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{0, 5, 1, 7}, result)
+}
+
+func TestFlatMapInvalidCallback(t *testing.T) {
+	_, err := From([]int{1}).
+		FlatMap(func(each int) int {
+			return each
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "callback return value data type should be slice")
+}
+
+func TestFlatten(t *testing.T) {
+	result, err := From([][]int{{1, 2}, {}, {3}}).Flatten().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2, 3}, result)
+}
+
+func TestFlattenChunk(t *testing.T) {
+	data := []string{"a", "b", "c", "d", "e"}
+	result, err := From(data).Chunk(2).Flatten().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, data, result)
+}
+
+func TestFlattenOneLevel(t *testing.T) {
+	result, err := From([][][]int{{{1}, {2}}, {{3}}}).Flatten().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1}, {2}, {3}}, result)
+}
+
+func TestFlattenInterface(t *testing.T) {
+	result, err := From([]interface{}{1, []int{2, 3}, []interface{}{4, []int{5}}, "six", nil}).Flatten().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{1, 2, 3, 4, []int{5}, "six", nil}, result)
+}
+
+func TestFlattenFlatData(t *testing.T) {
+	result, err := From([]int{1, 2}).Flatten().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2}, result)
+}
+
+func TestFlattenDeep(t *testing.T) {
+	result, err := From([][][]int{{{1}, {2, 3}}, {{4}}}).FlattenDeep().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2, 3, 4}, result)
+}
+
+func TestFlattenDeepInterface(t *testing.T) {
+	result, err := From([]interface{}{1, []interface{}{2, []interface{}{3, [][]int{{4}}}}}).FlattenDeep().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{1, 2, 3, 4}, result)
+}
+
+func TestFlattenDepth(t *testing.T) {
+	data := [][][]int{{{1}, {2, 3}}, {{4}}}
+
+	result, err := From(data).FlattenDepth(0).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, data, result)
+
+	result, err = From(data).FlattenDepth(2).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2, 3, 4}, result)
+
+	result, err = From(data).FlattenDepth(5).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2, 3, 4}, result)
+
+	_, err = From(data).FlattenDepth(-1).ResultAndError()
+	assert.EqualError(t, err, "depth should not be negative number")
+}
+
+func TestFrequencies(t *testing.T) {
+	result, err := From([]string{"a", "b", "a", "c", "a", "b"}).Frequencies().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[string]int{"a": 3, "b": 2, "c": 1}, result)
+}
+
+func TestFrequenciesEmptyData(t *testing.T) {
+	result, err := From([]int{}).Frequencies().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[int]int{}, result)
+}
+
+func TestFrequenciesNilData(t *testing.T) {
+	_, err := From(nil).Frequencies().ResultAndError()
+
+	assert.EqualError(t, err, "data cannot be nil")
+}
+
 func TestFromPairsWithDataStringInt(t *testing.T) {
 	data := []interface{}{
 		[]interface{}{"a", 1},
@@ -1764,6 +2426,57 @@ func TestFromPairsWithInvalidType(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestFullOuterJoin(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	result, err := From(orders).
+		FullOuterJoin(customers, orderKey, customerKey, func(order *Order, customer *Customer) string {
+			if order == nil {
+				return fmt.Sprintf("-:%s", customer.Name)
+			}
+
+			if customer == nil {
+				return fmt.Sprintf("%d:-", order.ID)
+			}
+
+			return fmt.Sprintf("%d:%s", order.ID, customer.Name)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"1:alice", "2:bob", "3:alice", "4:-", "-:carol"}, result)
+}
+
 func TestGroupBy(t *testing.T) {
 	type Sample struct {
 		Ebook    string
@@ -1852,409 +2565,1382 @@ func TestGroupByWithFlatDataInt(t *testing.T) {
 	}
 }
 
-func TestIndexOf(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
-	result, err := From(data).IndexOf("tim").ResultAndError()
+func TestGroupByAggregate(t *testing.T) {
+	type Sale struct {
+		Region string
+		Amount int
+		Price  float64
+	}
 
-	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
-}
+	data := []Sale{
+		{Region: "east", Amount: 3, Price: 1.5},
+		{Region: "west", Amount: 5, Price: 2},
+		{Region: "east", Amount: 7, Price: 4.5},
+		{Region: "north", Amount: 1, Price: 10},
+		{Region: "west", Amount: 2, Price: 1},
+	}
 
-func TestIndexOfWithFromIndex(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
-	result, err := From(data).IndexOf("tim", 4).ResultAndError()
+	byRegion := func(each Sale) string {
+		return each.Region
+	}
+
+	result, err := From(data).GroupBy(byRegion).
+		Aggregate(func(acc int, each Sale) int {
+			return acc + each.Amount*10
+		}, 0).
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 4, result)
+	assert.EqualValues(t, map[string]int{"east": 100, "west": 70, "north": 10}, result)
 }
 
-func TestIndexOfWithWrongSearchData(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
-	result, err := From(data).IndexOf("hello").ResultAndError()
+func TestGroupByCount(t *testing.T) {
+	type Sale struct {
+		Region string
+		Amount int
+		Price  float64
+	}
 
-	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
-}
+	data := []Sale{
+		{Region: "east", Amount: 3, Price: 1.5},
+		{Region: "west", Amount: 5, Price: 2},
+		{Region: "east", Amount: 7, Price: 4.5},
+		{Region: "north", Amount: 1, Price: 10},
+		{Region: "west", Amount: 2, Price: 1},
+	}
 
-func TestIndexOfWithWrongSearchDataWithDifferentType(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
-	result, err := From(data).IndexOf(make(map[string]string, 0)).ResultAndError()
+	byRegion := func(each Sale) string {
+		return each.Region
+	}
+
+	result, err := From(data).GroupBy(byRegion).Count().ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
+	assert.EqualValues(t, map[string]int{"east": 2, "west": 2, "north": 1}, result)
 }
 
-func TestIndexOfWithFromIndexMinus7(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", -7).ResultAndError()
+func TestGroupBySumAvg(t *testing.T) {
+	type Sale struct {
+		Region string
+		Amount int
+		Price  float64
+	}
 
-	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
-}
+	data := []Sale{
+		{Region: "east", Amount: 3, Price: 1.5},
+		{Region: "west", Amount: 5, Price: 2},
+		{Region: "east", Amount: 7, Price: 4.5},
+		{Region: "north", Amount: 1, Price: 10},
+		{Region: "west", Amount: 2, Price: 1},
+	}
 
-func TestIndexOfWithFromIndexMinus6(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", -6).ResultAndError()
+	byRegion := func(each Sale) string {
+		return each.Region
+	}
+
+	sum, err := From(data).GroupBy(byRegion).Sum(func(each Sale) int { return each.Amount }).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
-}
+	assert.EqualValues(t, map[string]int{"east": 10, "west": 7, "north": 1}, sum)
 
-func TestIndexOfWithFromIndexMinus5(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", -5).ResultAndError()
+	avg, err := From(data).GroupBy(byRegion).Avg(func(each Sale) float64 { return each.Price }).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
+	assert.EqualValues(t, map[string]float64{"east": 3, "west": 1.5, "north": 10}, avg)
 }
 
-func TestIndexOfWithFromIndexMinus4(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", -4).ResultAndError()
+func TestGroupBySumFlatData(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4, 5}).
+		GroupBy(func(each int) bool { return each%2 == 0 }).
+		Sum().
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
+	assert.EqualValues(t, map[bool]int{true: 6, false: 9}, result)
 }
 
-func TestIndexOfWithFromIndexMinus3(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", -3).ResultAndError()
+func TestGroupBySumNilIteratee(t *testing.T) {
+	byParity := func(each int) bool { return each%2 == 0 }
+
+	sum, err := From([]int{1, 2, 3, 4, 5}).GroupBy(byParity).Sum(nil).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
-}
+	assert.EqualValues(t, map[bool]int{true: 6, false: 9}, sum)
 
-func TestIndexOfWithFromIndexMinus2(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", -2).ResultAndError()
+	avg, err := From([]int{1, 2, 3, 4, 5}).GroupBy(byParity).Avg(nil).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 4, result)
+	assert.EqualValues(t, map[bool]float64{true: 3, false: 3}, avg)
 }
 
-func TestIndexOfWithFromIndexMinus1(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", -1).ResultAndError()
+func TestGroupBySumOverflow(t *testing.T) {
+	byAll := func(each int8) string { return "all" }
 
-	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
-}
+	result, err := From([]int8{100, 100}).GroupBy(byAll).Sum().ResultAndError()
 
-func TestIndexOfWithFromIndexZero(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", 0).ResultAndError()
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "sum of group all overflows int8")
 
-	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
-}
+	result, err = From([]int64{math.MaxInt64, 1}).GroupBy(func(each int64) string { return "all" }).Sum().ResultAndError()
 
-func TestIndexOfWithFromIndex1(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", 1).ResultAndError()
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "sum of group all overflows int64")
+
+	result, err = From([]int64{math.MaxInt64, 1, -2}).GroupBy(func(each int64) string { return "all" }).Sum().ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
+	assert.EqualValues(t, map[string]int64{"all": math.MaxInt64 - 1}, result)
 }
 
-func TestIndexOfWithFromIndex2(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", 2).ResultAndError()
+func TestGroupByAvgCompensated(t *testing.T) {
+	result, err := From([]float64{1e16, 1, -1e16, 1}).
+		GroupBy(func(each float64) string { return "all" }).
+		Avg().
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
+	assert.EqualValues(t, map[string]float64{"all": 0.5}, result)
 }
 
-func TestIndexOfWithFromIndex3(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", 3).ResultAndError()
+func TestGroupBySumInvalidData(t *testing.T) {
+	type Sale struct {
+		Region string
+		Amount int
+		Price  float64
+	}
 
-	assert.Nil(t, err)
-	assert.Equal(t, 3, result)
-}
+	data := []Sale{
+		{Region: "east", Amount: 3, Price: 1.5},
+		{Region: "west", Amount: 5, Price: 2},
+		{Region: "east", Amount: 7, Price: 4.5},
+		{Region: "north", Amount: 1, Price: 10},
+		{Region: "west", Amount: 2, Price: 1},
+	}
 
-func TestIndexOfWithFromIndex4(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", 4).ResultAndError()
+	byRegion := func(each Sale) string {
+		return each.Region
+	}
 
-	assert.Nil(t, err)
-	assert.Equal(t, 4, result)
+	_, err := From(data).GroupBy(byRegion).Sum().ResultAndError()
+
+	assert.EqualError(t, err, "group element data type should be number, or iteratee should be provided")
 }
 
-func TestIndexOfWithFromIndex5(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", 5).ResultAndError()
+func TestGroupByMinMax(t *testing.T) {
+	type Sale struct {
+		Region string
+		Amount int
+		Price  float64
+	}
 
-	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
-}
+	data := []Sale{
+		{Region: "east", Amount: 3, Price: 1.5},
+		{Region: "west", Amount: 5, Price: 2},
+		{Region: "east", Amount: 7, Price: 4.5},
+		{Region: "north", Amount: 1, Price: 10},
+		{Region: "west", Amount: 2, Price: 1},
+	}
 
-func TestIndexOfWithFromIndex6(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", 6).ResultAndError()
+	byRegion := func(each Sale) string {
+		return each.Region
+	}
+
+	amountOf := func(each Sale) int { return each.Amount }
+
+	min, err := From(data).GroupBy(byRegion).Min(amountOf).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
-}
+	assert.EqualValues(t, map[string]Sale{
+		"east":  {Region: "east", Amount: 3, Price: 1.5},
+		"west":  {Region: "west", Amount: 2, Price: 1},
+		"north": {Region: "north", Amount: 1, Price: 10},
+	}, min)
 
-func TestIndexOfWithFromIndex7(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).IndexOf("tim", 7).ResultAndError()
+	max, err := From(data).GroupBy(byRegion).Max(amountOf).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
+	assert.EqualValues(t, map[string]Sale{
+		"east":  {Region: "east", Amount: 7, Price: 4.5},
+		"west":  {Region: "west", Amount: 5, Price: 2},
+		"north": {Region: "north", Amount: 1, Price: 10},
+	}, max)
 }
 
-func TestIndexOfWithInvalidSearchData(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
-	result, err := From(data).IndexOf(12).ResultAndError()
+func TestGroupByFirstLast(t *testing.T) {
+	type Sale struct {
+		Region string
+		Amount int
+		Price  float64
+	}
+
+	data := []Sale{
+		{Region: "east", Amount: 3, Price: 1.5},
+		{Region: "west", Amount: 5, Price: 2},
+		{Region: "east", Amount: 7, Price: 4.5},
+		{Region: "north", Amount: 1, Price: 10},
+		{Region: "west", Amount: 2, Price: 1},
+	}
+
+	byRegion := func(each Sale) string {
+		return each.Region
+	}
+
+	first := From(data).GroupBy(byRegion).First().Result()
+	last := From(data).GroupBy(byRegion).Last().Result()
+
+	assert.Equal(t, 3, first.(map[string]Sale)["east"].Amount)
+	assert.Equal(t, 7, last.(map[string]Sale)["east"].Amount)
+}
+
+func TestGroupByMapGroups(t *testing.T) {
+	type Sale struct {
+		Region string
+		Amount int
+		Price  float64
+	}
+
+	data := []Sale{
+		{Region: "east", Amount: 3, Price: 1.5},
+		{Region: "west", Amount: 5, Price: 2},
+		{Region: "east", Amount: 7, Price: 4.5},
+		{Region: "north", Amount: 1, Price: 10},
+		{Region: "west", Amount: 2, Price: 1},
+	}
+
+	byRegion := func(each Sale) string {
+		return each.Region
+	}
+
+	result, err := From(data).GroupBy(byRegion).
+		MapGroups(func(group []Sale, key string) string {
+			return fmt.Sprintf("%s:%d", key, len(group))
+		}).
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
+	assert.EqualValues(t, map[string]string{"east": "east:2", "west": "west:2", "north": "north:1"}, result)
 }
 
-func TestIndexOfWithNilSearchData(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
-	result, err := From(data).IndexOf(nil).ResultAndError()
+func TestGroupByFilterGroupsRows(t *testing.T) {
+	type Sale struct {
+		Region string
+		Amount int
+		Price  float64
+	}
+
+	data := []Sale{
+		{Region: "east", Amount: 3, Price: 1.5},
+		{Region: "west", Amount: 5, Price: 2},
+		{Region: "east", Amount: 7, Price: 4.5},
+		{Region: "north", Amount: 1, Price: 10},
+		{Region: "west", Amount: 2, Price: 1},
+	}
+
+	byRegion := func(each Sale) string {
+		return each.Region
+	}
+
+	result, err := From(data).GroupBy(byRegion).
+		FilterGroups(func(group []Sale) bool {
+			return len(group) > 1
+		}).
+		Count().
+		Rows().
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
+	assert.EqualValues(t, []KeyValue{{Key: "east", Value: 2}, {Key: "west", Value: 2}}, result)
 }
 
-func TestInitial(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra"}
-	result, err := From(data).Initial().ResultAndError()
+func TestGroupByChain(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4, 5}).
+		GroupBy(func(each int) bool { return each%2 == 0 }).
+		Chain().
+		Size().
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.EqualValues(t, []string{"damian", "grayson"}, result)
+	assert.Equal(t, 2, result)
 }
 
-func TestInitialEmptyData(t *testing.T) {
-	data := []int{}
-	result, err := From(data).Initial().ResultAndError()
+func TestGroupByReducerError(t *testing.T) {
+	chain := From([]int{1, 2}).GroupBy("invalid").Count()
+
+	assert.EqualError(t, chain.Error(), "callback should be function")
+	assert.Equal(t, Operation(OperationGroupBy), chain.LastErrorOperation())
+	assert.Equal(t, Operation(OperationCount), chain.LastOperation())
+}
+
+func TestGroupByMany(t *testing.T) {
+	type Sale struct {
+		Region string
+		Month  int
+		Amount int
+	}
+
+	data := []Sale{
+		{Region: "west", Month: 2, Amount: 10},
+		{Region: "east", Month: 1, Amount: 20},
+		{Region: "west", Month: 1, Amount: 30},
+		{Region: "west", Month: 2, Amount: 40},
+		{Region: "east", Month: 3, Amount: 50},
+	}
+
+	result, err := From(data).
+		GroupByMany(func(each Sale) string {
+			return each.Region
+		}, func(each Sale) int {
+			return each.Month
+		}).
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.EqualValues(t, []int{}, result)
+	assert.EqualValues(t, map[string]map[int][]Sale{
+		"west": {
+			2: {data[0], data[3]},
+			1: {data[2]},
+		},
+		"east": {
+			1: {data[1]},
+			3: {data[4]},
+		},
+	}, result)
 }
 
-func TestIntersectionMany(t *testing.T) {
-	result, err := From([]string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}).
-		IntersectionMany(
-			[]string{"cassandra", "tim", "jason"},
-			[]string{"cassandra", "jason"},
-		).
+func TestGroupByManySingleLevel(t *testing.T) {
+	result, err := From([]int{1, 2, 3}).
+		GroupByMany(func(each int) bool { return each > 1 }).
 		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.EqualValues(t, []string{"cassandra", "jason"}, result)
+	assert.EqualValues(t, map[bool][]int{false: {1}, true: {2, 3}}, result)
 }
 
-func TestIntersectionWithEmptyComparison(t *testing.T) {
-	result, err := From([]string{"damian", "grayson", "cassandra"}).Intersection([]string{}).ResultAndError()
+func TestGroupByManyEmptyData(t *testing.T) {
+	type Sale struct {
+		Region string
+		Month  int
+		Amount int
+	}
+
+	result, err := From([]Sale{}).
+		GroupByMany(func(each Sale) string {
+			return each.Region
+		}, func(each Sale) int {
+			return each.Month
+		}).
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.EqualValues(t, []string{}, result)
+	assert.EqualValues(t, map[string]map[int][]Sale{}, result)
 }
 
-func TestJoin(t *testing.T) {
-	result, err := From([]string{"damian", "grayson", "cassandra"}).Join("|").ResultAndError()
+func TestGroupByManyPredicateCalledOncePerElement(t *testing.T) {
+	data := []string{"a1", "b1", "a2", "a1"}
+
+	calls := make([]int, 2)
+	indexes := make([]int, 0)
+	result, err := From(data).
+		GroupByMany(func(each string) byte {
+			calls[0]++
+			return each[0]
+		}, func(each string, i int) byte {
+			calls[1]++
+			indexes = append(indexes, i)
+			return each[1]
+		}).
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, "damian|grayson|cassandra", result)
+	assert.EqualValues(t, []int{4, 4}, calls)
+	// the 2nd level is invoked group by group, with the index of each element in data
+	assert.EqualValues(t, []int{0, 2, 3, 1}, indexes)
+	assert.EqualValues(t, map[byte]map[byte][]string{
+		'a': {'1': {"a1", "a1"}, '2': {"a2"}},
+		'b': {'1': {"b1"}},
+	}, result)
 }
 
-func TestJoinIntData(t *testing.T) {
-	result, err := From([]int{1, 2, 3, 4}).Join(",").ResultAndError()
+func TestGroupByManyOrderedOriginalIndex(t *testing.T) {
+	data := []string{"x", "y", "x", "y"}
+
+	result, err := From(data).
+		GroupByManyOrdered(func(each string) string {
+			return each
+		}, func(each string, i int) int {
+			return i
+		}).
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, "1,2,3,4", result)
+	assert.EqualValues(t, []GroupNode{
+		{Key: "x", Items: []string{"x", "x"}, Children: []GroupNode{
+			{Key: 0, Items: []string{"x"}},
+			{Key: 2, Items: []string{"x"}},
+		}},
+		{Key: "y", Items: []string{"y", "y"}, Children: []GroupNode{
+			{Key: 1, Items: []string{"y"}},
+			{Key: 3, Items: []string{"y"}},
+		}},
+	}, result)
 }
 
-func TestKeyBy(t *testing.T) {
-	type HashMap map[string]string
+func TestGroupByManyOrderedUnhashableKey(t *testing.T) {
+	_, err := From([]int{1, 2}).
+		GroupByManyOrdered(func(each int) interface{} {
+			return []int{each}
+		}).
+		ResultAndError()
 
-	data := []HashMap{
-		{"name": "grayson", "hobby": "helping people"},
-		{"name": "jason", "hobby": "punching people"},
-		{"name": "tim", "hobby": "stay awake all the time"},
-		{"name": "damian", "hobby": "getting angry"},
+	assert.EqualError(t, err, "key [1] is not hashable, it holds value of non-comparable type []int")
+}
+
+func TestGroupByManyNoPredicate(t *testing.T) {
+	_, err := From([]int{1, 2, 3}).GroupByMany().ResultAndError()
+
+	assert.EqualError(t, err, "predicates cannot be empty")
+}
+
+func TestGroupByManyInvalidPredicate(t *testing.T) {
+	_, err := From([]int{1, 2, 3}).
+		GroupByMany(func(each int) int { return each }, func(each string) string { return each }).
+		ResultAndError()
+
+	assert.EqualError(t, err, "callback 1st parameter's data type should be same with slice element data type")
+}
+
+func TestGroupByManyOrdered(t *testing.T) {
+	type Sale struct {
+		Region string
+		Month  int
+		Amount int
+	}
+
+	data := []Sale{
+		{Region: "west", Month: 2, Amount: 10},
+		{Region: "east", Month: 1, Amount: 20},
+		{Region: "west", Month: 1, Amount: 30},
+		{Region: "west", Month: 2, Amount: 40},
+		{Region: "east", Month: 3, Amount: 50},
 	}
 
 	result, err := From(data).
-		KeyBy(func(each HashMap) string {
-			return each["name"]
+		GroupByManyOrdered(func(each Sale) string {
+			return each.Region
+		}, func(each Sale) int {
+			return each.Month
 		}).
 		ResultAndError()
+
 	assert.Nil(t, err)
+	assert.EqualValues(t, []GroupNode{
+		{
+			Key:   "west",
+			Items: []Sale{data[0], data[2], data[3]},
+			Children: []GroupNode{
+				{Key: 2, Items: []Sale{data[0], data[3]}},
+				{Key: 1, Items: []Sale{data[2]}},
+			},
+		},
+		{
+			Key:   "east",
+			Items: []Sale{data[1], data[4]},
+			Children: []GroupNode{
+				{Key: 1, Items: []Sale{data[1]}},
+				{Key: 3, Items: []Sale{data[4]}},
+			},
+		},
+	}, result)
+}
 
-	for key, value := range result.(map[string]HashMap) {
-		switch key {
-		case "grayson":
-			assert.EqualValues(t, HashMap{"name": "grayson", "hobby": "helping people"}, value)
-		case "jason":
-			assert.EqualValues(t, HashMap{"name": "jason", "hobby": "punching people"}, value)
-		case "tim":
-			assert.EqualValues(t, HashMap{"name": "tim", "hobby": "stay awake all the time"}, value)
-		case "damian":
-			assert.EqualValues(t, HashMap{"name": "damian", "hobby": "getting angry"}, value)
-		default:
-			assert.Error(t, errors.New("KeyBy return value is wrong"))
-		}
-	}
+func TestGroupByOrdered(t *testing.T) {
+	data := []int{5, 2, 8, 1, 4, 9}
+
+	result, err := From(data).
+		GroupByOrdered(func(each int) string {
+			if each%2 == 0 {
+				return "even"
+			}
+
+			return "odd"
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+
+	orderedMap := result.(*OrderedMap)
+	assert.EqualValues(t, []interface{}{"odd", "even"}, orderedMap.Keys())
+
+	odd, _ := orderedMap.Get("odd")
+	assert.EqualValues(t, []int{5, 1, 9}, odd)
+
+	even, _ := orderedMap.Get("even")
+	assert.EqualValues(t, []int{2, 8, 4}, even)
 }
 
-func TestLast(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra"}
-	result, err := From(data).Last().ResultAndError()
+func TestGroupByOrderedChain(t *testing.T) {
+	data := []string{"b1", "a1", "b2", "c1", "a2"}
+
+	result, err := From(From(data).
+		GroupByOrdered(func(each string) string {
+			return each[:1]
+		}).
+		Result()).
+		Map(func(each KeyValue) string {
+			return fmt.Sprintf("%s=%d", each.Key, len(each.Value.([]string)))
+		}).
+		Join(",").
+		ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, "cassandra", result)
+	assert.Equal(t, "b=2,a=2,c=1", result)
 }
 
-func TestLastWithEmptyData(t *testing.T) {
-	data := []string{}
-	result, err := From(data).Last().ResultAndError()
+func TestGroupByOrderedInvalidPredicate(t *testing.T) {
+	result, err := From([]int{1}).GroupByOrdered(func(each string) string { return each }).ResultAndError()
+
+	assert.EqualError(t, err, "callback 1st parameter's data type should be same with slice element data type")
+	assert.Nil(t, result)
+}
+
+func TestIndexOf(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
+	result, err := From(data).IndexOf("tim").ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, nil, result)
+	assert.Equal(t, 3, result)
 }
 
-func TestLastWithNilData(t *testing.T) {
-	result, err := From(nil).Last().ResultAndError()
+func TestIndexOfWithFromIndex(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
+	result, err := From(data).IndexOf("tim", 4).ResultAndError()
 
-	assert.NotNil(t, err)
-	assert.EqualError(t, err, "data cannot be nil")
-	assert.Equal(t, nil, result)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result)
 }
 
-func TestLastIndexOfWithFromIndexMinus7(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", -7).ResultAndError()
+func TestIndexOfWithWrongSearchData(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
+	result, err := From(data).IndexOf("hello").ResultAndError()
 
 	assert.Nil(t, err)
 	assert.Equal(t, -1, result)
 }
 
-func TestLastIndexOfWithFromIndexMinus6(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", -6).ResultAndError()
+func TestIndexOfWithWrongSearchDataWithDifferentType(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
+	result, err := From(data).IndexOf(make(map[string]string, 0)).ResultAndError()
 
 	assert.Nil(t, err)
 	assert.Equal(t, -1, result)
 }
 
-func TestLastIndexOfWithFromIndexMinus5(t *testing.T) {
+func TestIndexOfWithFromIndexMinus7(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", -5).ResultAndError()
+	result, err := From(data).IndexOf("tim", -7).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
+	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndexMinus4(t *testing.T) {
+func TestIndexOfWithFromIndexMinus6(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", -4).ResultAndError()
+	result, err := From(data).IndexOf("tim", -6).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
+	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndexMinus3(t *testing.T) {
+func TestIndexOfWithFromIndexMinus5(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", -3).ResultAndError()
+	result, err := From(data).IndexOf("tim", -5).ResultAndError()
 
 	assert.Nil(t, err)
 	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndexMinus2(t *testing.T) {
+func TestIndexOfWithFromIndexMinus4(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", -2).ResultAndError()
+	result, err := From(data).IndexOf("tim", -4).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 4, result)
+	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndexMinus1(t *testing.T) {
+func TestIndexOfWithFromIndexMinus3(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", -1).ResultAndError()
+	result, err := From(data).IndexOf("tim", -3).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 4, result)
+	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndexZero(t *testing.T) {
+func TestIndexOfWithFromIndexMinus2(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", 0).ResultAndError()
+	result, err := From(data).IndexOf("tim", -2).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
+	assert.Equal(t, 4, result)
 }
 
-func TestLastIndexOfWithFromIndex1(t *testing.T) {
+func TestIndexOfWithFromIndexMinus1(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", 1).ResultAndError()
+	result, err := From(data).IndexOf("tim", -1).ResultAndError()
 
 	assert.Nil(t, err)
 	assert.Equal(t, -1, result)
 }
 
-func TestLastIndexOfWithFromIndex2(t *testing.T) {
+func TestIndexOfWithFromIndexZero(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", 2).ResultAndError()
+	result, err := From(data).IndexOf("tim", 0).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, -1, result)
+	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndex3(t *testing.T) {
+func TestIndexOfWithFromIndex1(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", 3).ResultAndError()
+	result, err := From(data).IndexOf("tim", 1).ResultAndError()
 
 	assert.Nil(t, err)
 	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndex4(t *testing.T) {
+func TestIndexOfWithFromIndex2(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", 4).ResultAndError()
+	result, err := From(data).IndexOf("tim", 2).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 4, result)
+	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndex5(t *testing.T) {
+func TestIndexOfWithFromIndex3(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", 5).ResultAndError()
+	result, err := From(data).IndexOf("tim", 3).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 4, result)
+	assert.Equal(t, 3, result)
 }
 
-func TestLastIndexOfWithFromIndex6(t *testing.T) {
+func TestIndexOfWithFromIndex4(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", 6).ResultAndError()
+	result, err := From(data).IndexOf("tim", 4).ResultAndError()
 
 	assert.Nil(t, err)
 	assert.Equal(t, 4, result)
 }
 
-func TestLastIndexOfWithFromIndex7(t *testing.T) {
+func TestIndexOfWithFromIndex5(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
-	result, err := From(data).LastIndexOf("tim", 7).ResultAndError()
+	result, err := From(data).IndexOf("tim", 5).ResultAndError()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 4, result)
+	assert.Equal(t, -1, result)
 }
 
-func TestLastIndexOfWithWrongSearchData(t *testing.T) {
-	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
-	result, err := From(data).LastIndexOf("hello").ResultAndError()
+func TestIndexOfWithFromIndex6(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).IndexOf("tim", 6).ResultAndError()
 
 	assert.Nil(t, err)
 	assert.Equal(t, -1, result)
 }
 
-func TestLastIndexOfWithInvalidSearchData(t *testing.T) {
+func TestIndexOfWithFromIndex7(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).IndexOf("tim", 7).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestIndexOfWithInvalidSearchData(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
+	result, err := From(data).IndexOf(12).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestIndexOfWithNilSearchData(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
+	result, err := From(data).IndexOf(nil).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestInitial(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra"}
+	result, err := From(data).Initial().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"damian", "grayson"}, result)
+}
+
+func TestInitialEmptyData(t *testing.T) {
+	data := []int{}
+	result, err := From(data).Initial().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{}, result)
+}
+
+func TestInnerJoin(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	result, err := From(orders).
+		InnerJoin(customers, orderKey, customerKey, func(order Order, customer Customer) string {
+			return fmt.Sprintf("%d:%s", order.ID, customer.Name)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"1:alice", "2:bob", "3:alice"}, result)
+}
+
+func TestInnerJoinMultipleMatches(t *testing.T) {
+	left := []string{"a", "b"}
+	right := []string{"a1", "b1", "a2"}
+
+	result, err := From(left).
+		InnerJoin(right, func(each string) string { return each }, func(each string) string { return each[:1] }, func(l, r string) string {
+			return l + "-" + r
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"a-a1", "a-a2", "b-b1"}, result)
+}
+
+func TestInnerJoinNonComparableKey(t *testing.T) {
+	left := [][]int{{1, 2}, {3}, {4}}
+	right := []string{"3", "1,2", "5"}
+
+	result, err := From(left).
+		InnerJoin(right, func(each []int) []int {
+			return each
+		}, func(each string) []int {
+			keys := make([]int, 0)
+			for _, part := range strings.Split(each, ",") {
+				key := 0
+				fmt.Sscan(part, &key)
+				keys = append(keys, key)
+			}
+
+			return keys
+		}, func(l []int, r string) string {
+			return r
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"1,2", "3"}, result)
+}
+
+func TestInnerJoinInterfaceKey(t *testing.T) {
+	left := []interface{}{1, []string{"x"}, nil}
+	right := []interface{}{[]string{"x"}, 1, nil}
+
+	identity := func(each interface{}) interface{} { return each }
+	result, err := From(left).
+		InnerJoin(right, identity, identity, func(l, r interface{}) interface{} { return r }).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{1, []string{"x"}}, result)
+}
+
+func TestInnerJoinUnhashableDynamicKey(t *testing.T) {
+	type Key struct {
+		Value interface{}
+	}
+
+	left := []Key{{Value: 1}, {Value: []int{2}}, {Value: []int{3}}}
+	right := []Key{{Value: []int{2}}, {Value: 1}}
+
+	identity := func(each Key) Key { return each }
+	result, err := From(left).
+		InnerJoin(right, identity, identity, func(l, r Key) Key { return r }).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Key{{Value: 1}, {Value: []int{2}}}, result)
+}
+
+func TestInnerJoinUnhashableDynamicKeyOnRightSide(t *testing.T) {
+	left := []interface{}{1, struct{ Value interface{} }{map[string]int{"a": 1}}}
+	right := []interface{}{struct{ Value interface{} }{map[string]int{"a": 1}}, 1}
+
+	identity := func(each interface{}) interface{} { return each }
+	result, err := From(left).
+		InnerJoin(right, identity, identity, func(l, r interface{}) int {
+			return 1
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 1}, result)
+}
+
+func TestInnerJoinNilKey(t *testing.T) {
+	type Item struct {
+		ID  int
+		Ref *int
+		Tag interface{}
+	}
+
+	left := []Item{{ID: 1}, {ID: 2}}
+	right := []Item{{ID: 3}}
+
+	result, err := From(left).
+		InnerJoin(right, func(each Item) *int {
+			return each.Ref
+		}, func(each Item) *int {
+			return each.Ref
+		}, func(l, r Item) int {
+			return l.ID
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{}, result)
+
+	result, err = From(left).
+		InnerJoin(right, func(each Item) interface{} {
+			return each.Tag
+		}, func(each Item) interface{} {
+			return each.Tag
+		}, func(l, r Item) int {
+			return l.ID
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{}, result)
+}
+
+func TestInnerJoinInvalidCombiner(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	_, err := From(orders).
+		InnerJoin(customers, orderKey, customerKey, func(order Order, customer string) string {
+			return ""
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "combiner 2nd parameter's data type should be same with slice element data type, or pointer of it")
+}
+
+func TestInnerJoinInvalidKey(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	_, err := From(orders).
+		InnerJoin(customers, func(each string) int { return 0 }, customerKey, func(order Order, customer Customer) int {
+			return 0
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "callback 1st parameter's data type should be same with slice element data type")
+}
+
+func TestIntersectionMany(t *testing.T) {
+	result, err := From([]string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}).
+		IntersectionMany(
+			[]string{"cassandra", "tim", "jason"},
+			[]string{"cassandra", "jason"},
+		).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"cassandra", "jason"}, result)
+}
+
+func TestIntersectionWithEmptyComparison(t *testing.T) {
+	result, err := From([]string{"damian", "grayson", "cassandra"}).Intersection([]string{}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{}, result)
+}
+
+func TestInterleave(t *testing.T) {
+	result, err := From([]int{1, 2, 3}).Interleave([]int{4, 5}, []int{6}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 4, 6, 2, 5, 3}, result)
+}
+
+func TestInterleaveLongerOthers(t *testing.T) {
+	result, err := From([]string{"a"}).Interleave([]string{"b", "c", "d"}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"a", "b", "c", "d"}, result)
+}
+
+func TestInterleaveDifferentDataType(t *testing.T) {
+	_, err := From([]int{1, 2}).Interleave([]string{"a"}).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "data type of each elements between slice must be same")
+}
+
+func TestInterleaveInvalidData(t *testing.T) {
+	_, err := From([]int{1, 2}).Interleave([]int{3}, 4).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "interleave data 2 must be slice")
+}
+
+func TestIntersperse(t *testing.T) {
+	result, err := From([]string{"a", "b", "c"}).Intersperse("-").ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"a", "-", "b", "-", "c"}, result)
+}
+
+func TestIntersperseShortData(t *testing.T) {
+	result, err := From([]int{1}).Intersperse(0).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1}, result)
+
+	result, err = From([]int{}).Intersperse(0).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{}, result)
+}
+
+func TestIntersperseNilSeparator(t *testing.T) {
+	result, err := From([]interface{}{1, "a"}).Intersperse(nil).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{1, nil, "a"}, result)
+
+	_, err = From([]int{1, 2}).Intersperse(nil).ResultAndError()
+	assert.EqualError(t, err, "separator cannot be nil")
+}
+
+func TestIntersperseDifferentDataType(t *testing.T) {
+	_, err := From([]float64{1, 2}).Intersperse(0).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "separator data type should be same with data element type")
+}
+
+func TestJoin(t *testing.T) {
+	result, err := From([]string{"damian", "grayson", "cassandra"}).Join("|").ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "damian|grayson|cassandra", result)
+}
+
+func TestJoinIntData(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4}).Join(",").ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "1,2,3,4", result)
+}
+
+func TestKeyBy(t *testing.T) {
+	type HashMap map[string]string
+
+	data := []HashMap{
+		{"name": "grayson", "hobby": "helping people"},
+		{"name": "jason", "hobby": "punching people"},
+		{"name": "tim", "hobby": "stay awake all the time"},
+		{"name": "damian", "hobby": "getting angry"},
+	}
+
+	result, err := From(data).
+		KeyBy(func(each HashMap) string {
+			return each["name"]
+		}).
+		ResultAndError()
+	assert.Nil(t, err)
+
+	for key, value := range result.(map[string]HashMap) {
+		switch key {
+		case "grayson":
+			assert.EqualValues(t, HashMap{"name": "grayson", "hobby": "helping people"}, value)
+		case "jason":
+			assert.EqualValues(t, HashMap{"name": "jason", "hobby": "punching people"}, value)
+		case "tim":
+			assert.EqualValues(t, HashMap{"name": "tim", "hobby": "stay awake all the time"}, value)
+		case "damian":
+			assert.EqualValues(t, HashMap{"name": "damian", "hobby": "getting angry"}, value)
+		default:
+			assert.Error(t, errors.New("KeyBy return value is wrong"))
+		}
+	}
+}
+
+func TestKeyByKeepFirst(t *testing.T) {
+	data := []string{"apple", "avocado", "banana"}
+
+	result, err := From(data).
+		KeyBy(func(each string) byte {
+			return each[0]
+		}, KeyByKeepFirst).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[byte]string{'a': "apple", 'b': "banana"}, result)
+}
+
+func TestKeyByKeepLast(t *testing.T) {
+	data := []string{"apple", "avocado", "banana"}
+
+	result, err := From(data).
+		KeyBy(func(each string) byte {
+			return each[0]
+		}, KeyByKeepLast).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[byte]string{'a': "avocado", 'b': "banana"}, result)
+}
+
+func TestKeyByErrorOnDuplicate(t *testing.T) {
+	data := []string{"apple", "banana", "cherry", "blueberry"}
+
+	result, err := From(data).
+		KeyBy(func(each string) string {
+			return each[:1]
+		}, KeyByErrorOnDuplicate).
+		ResultAndError()
+
+	duplicateErr := new(DuplicateKeyError)
+	assert.True(t, errors.As(err, &duplicateErr))
+	assert.Equal(t, "b", duplicateErr.Key)
+	assert.Equal(t, 1, duplicateErr.FirstIndex)
+	assert.Equal(t, 3, duplicateErr.SecondIndex)
+	assert.EqualError(t, err, "duplicate key b found at index 1 and 3")
+	assert.Nil(t, result)
+}
+
+func TestKeyByErrorOnDuplicateWithoutDuplicate(t *testing.T) {
+	result, err := From([]int{1, 2, 3}).
+		KeyBy(func(each int) int {
+			return each
+		}, KeyByErrorOnDuplicate).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[int]int{1: 1, 2: 2, 3: 3}, result)
+}
+
+func TestKeyByMerge(t *testing.T) {
+	type stock struct {
+		SKU      string
+		Quantity int
+	}
+
+	data := []stock{{SKU: "a", Quantity: 1}, {SKU: "b", Quantity: 2}, {SKU: "a", Quantity: 3}}
+
+	result, err := From(data).
+		KeyBy(func(each stock) string {
+			return each.SKU
+		}, KeyByMerge(func(existing, each stock) stock {
+			existing.Quantity += each.Quantity
+			return existing
+		})).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[string]stock{"a": {SKU: "a", Quantity: 4}, "b": {SKU: "b", Quantity: 2}}, result)
+}
+
+func TestKeyByMergeInvalidCombiner(t *testing.T) {
+	_, err := From([]int{1, 2}).
+		KeyBy(func(each int) int {
+			return each
+		}, KeyByMerge(func(existing, each string) string {
+			return existing
+		})).
+		ResultAndError()
+
+	assert.EqualError(t, err, "combiner must have two parameters and one return value, all with the same data type as the element")
+}
+
+func TestKeyByOrdered(t *testing.T) {
+	type sample struct {
+		ID   string
+		Name string
+	}
+
+	data := []sample{{ID: "z", Name: "first"}, {ID: "a", Name: "second"}, {ID: "z", Name: "third"}}
+
+	result, err := From(data).
+		KeyByOrdered(func(each sample) string {
+			return each.ID
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []KeyValue{
+		{Key: "z", Value: sample{ID: "z", Name: "third"}},
+		{Key: "a", Value: sample{ID: "a", Name: "second"}},
+	}, result.(*OrderedMap).Pairs())
+}
+
+func TestKeyByOrderedErrorOnDuplicate(t *testing.T) {
+	_, err := From([]int{1, 2, 3, 4}).
+		KeyByOrdered(func(each int) bool {
+			return each%2 == 0
+		}, KeyByErrorOnDuplicate).
+		ResultAndError()
+
+	assert.EqualError(t, err, "duplicate key false found at index 0 and 2")
+}
+
+func TestKeyByOrderedKeepFirst(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4}).
+		KeyByOrdered(func(each int) bool {
+			return each%2 == 0
+		}, KeyByKeepFirst).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []KeyValue{{Key: false, Value: 1}, {Key: true, Value: 2}}, result.(*OrderedMap).Pairs())
+}
+
+func TestLast(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra"}
+	result, err := From(data).Last().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "cassandra", result)
+}
+
+func TestLastWithEmptyData(t *testing.T) {
+	data := []string{}
+	result, err := From(data).Last().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, nil, result)
+}
+
+func TestLastWithNilData(t *testing.T) {
+	result, err := From(nil).Last().ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "data cannot be nil")
+	assert.Equal(t, nil, result)
+}
+
+func TestLastIndexOfWithFromIndexMinus7(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", -7).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestLastIndexOfWithFromIndexMinus6(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", -6).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestLastIndexOfWithFromIndexMinus5(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", -5).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestLastIndexOfWithFromIndexMinus4(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", -4).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestLastIndexOfWithFromIndexMinus3(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", -3).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result)
+}
+
+func TestLastIndexOfWithFromIndexMinus2(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", -2).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result)
+}
+
+func TestLastIndexOfWithFromIndexMinus1(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", -1).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result)
+}
+
+func TestLastIndexOfWithFromIndexZero(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", 0).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestLastIndexOfWithFromIndex1(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", 1).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestLastIndexOfWithFromIndex2(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", 2).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestLastIndexOfWithFromIndex3(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", 3).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result)
+}
+
+func TestLastIndexOfWithFromIndex4(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", 4).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result)
+}
+
+func TestLastIndexOfWithFromIndex5(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", 5).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result)
+}
+
+func TestLastIndexOfWithFromIndex6(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", 6).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result)
+}
+
+func TestLastIndexOfWithFromIndex7(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason"}
+	result, err := From(data).LastIndexOf("tim", 7).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result)
+}
+
+func TestLastIndexOfWithWrongSearchData(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
+	result, err := From(data).LastIndexOf("hello").ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestLastIndexOfWithInvalidSearchData(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra", "tim", "tim", "jason", "stephanie"}
 	result, err := From(data).LastIndexOf(12).ResultAndError()
 
@@ -2270,6 +3956,49 @@ func TestLastIndexOfWithNilSearchData(t *testing.T) {
 	assert.Equal(t, -1, result)
 }
 
+func TestLeftJoin(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	result, err := From(orders).
+		LeftJoin(customers, orderKey, customerKey, func(order Order, customer Customer) string {
+			return fmt.Sprintf("%d:%s", order.ID, customer.Name)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"1:alice", "2:bob", "3:alice", "4:"}, result)
+}
+
 func TestMap(t *testing.T) {
 	type Sample struct {
 		EbookName      string
@@ -2332,6 +4061,227 @@ func TestMapToNewStruct(t *testing.T) {
 	}, newData)
 }
 
+func TestMax(t *testing.T) {
+	result, err := From([]int{3, 9, -2, 9, 4}).Max().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 9, result)
+}
+
+func TestMaxWithIteratee(t *testing.T) {
+	result, err := From([]string{"pear", "avocado", "fig"}).
+		Max(func(each string) int {
+			return len(each)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 7, result)
+}
+
+func TestMaxEmptyData(t *testing.T) {
+	result, err := From([]int{}).Max().ResultAndError()
+
+	assert.EqualError(t, err, "data cannot be empty")
+	assert.Nil(t, result)
+}
+
+func TestMaxNaN(t *testing.T) {
+	result, err := From([]float64{1, math.NaN(), 3}).Max().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.True(t, math.IsNaN(result.(float64)))
+
+	result, err = From([]float64{math.NaN(), 1, 3}).Max().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.True(t, math.IsNaN(result.(float64)))
+}
+
+func TestMaxNotComparable(t *testing.T) {
+	_, err := From([]interface{}{1, "a"}).Max().ResultAndError()
+
+	assert.EqualError(t, err, "cannot compare a with 1")
+}
+
+func TestMaxBy(t *testing.T) {
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	result, index, err := From([]Customer{{ID: 1, Name: "damian"}, {ID: 3, Name: "grayson"}, {ID: 3, Name: "jason"}}).
+		MaxBy(func(each Customer) int {
+			return each.ID
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, Customer{ID: 3, Name: "grayson"}, result)
+	assert.EqualValues(t, 1, index)
+}
+
+func TestMaxByEmptyData(t *testing.T) {
+	result := From([]string{}).MaxBy(func(each string) string {
+		return each
+	})
+
+	assert.Nil(t, result.Error())
+	assert.Nil(t, result.Result())
+	assert.EqualValues(t, -1, result.Index())
+}
+
+func TestMaxByNilIteratee(t *testing.T) {
+	result := From([]int{1, 2}).MaxBy(nil)
+
+	assert.EqualError(t, result.Error(), "iteratee cannot be nil")
+	assert.EqualValues(t, -1, result.Index())
+}
+
+func TestMean(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4}).Mean().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2.5, result)
+}
+
+func TestMeanLargeIntegers(t *testing.T) {
+	result, err := From([]int64{math.MaxInt64, math.MaxInt64}).Mean().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, float64(math.MaxInt64), result)
+}
+
+func TestMeanEmptyData(t *testing.T) {
+	_, err := From([]float64{}).Mean().ResultAndError()
+
+	assert.EqualError(t, err, "data cannot be empty")
+}
+
+func TestMeanNotNumber(t *testing.T) {
+	_, err := From([]string{"a"}).Mean().ResultAndError()
+
+	assert.EqualError(t, err, "element data type should be number, or iteratee should be provided")
+}
+
+func TestMin(t *testing.T) {
+	result, err := From([]float64{3.5, -1.25, 8}).Min().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, -1.25, result)
+}
+
+func TestMinTime(t *testing.T) {
+	early := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := early.Add(time.Hour)
+	result, err := From([]time.Time{late, early}).Min().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, early, result)
+}
+
+func TestMinEmptyData(t *testing.T) {
+	result, err := From([]int{}).Min().ResultAndError()
+
+	assert.EqualError(t, err, "data cannot be empty")
+	assert.Nil(t, result)
+}
+
+func TestMinNaN(t *testing.T) {
+	result, err := From([]float64{3, math.NaN(), 1}).Min().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.True(t, math.IsNaN(result.(float64)))
+
+	result, err = From([]float64{math.NaN(), 3, 1}).Min().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.True(t, math.IsNaN(result.(float64)))
+}
+
+func TestMinByNaN(t *testing.T) {
+	result, index, err := From([]float64{3, math.NaN(), 1}).
+		MinBy(func(each float64) float64 {
+			return each
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.True(t, math.IsNaN(result.(float64)))
+	assert.Equal(t, 1, index)
+}
+
+func TestGroupByMaxNaN(t *testing.T) {
+	result, err := From([]float64{1, math.NaN(), 3}).
+		GroupBy(func(each float64) bool {
+			return true
+		}).
+		Max().
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.True(t, math.IsNaN(result.(map[bool]float64)[true]))
+}
+
+func TestMinBy(t *testing.T) {
+	result, index, err := From([]string{"pear", "fig", "kiwi", "yam"}).
+		MinBy(func(each string) int {
+			return len(each)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, "fig", result)
+	assert.EqualValues(t, 1, index)
+}
+
+func TestMinByInvalidIteratee(t *testing.T) {
+	result := From([]string{"a"}).MinBy(func(each int) int {
+		return each
+	})
+
+	assert.EqualError(t, result.Error(), "iteratee must only have one parameter with the same data type with element data type")
+}
+
+func TestMode(t *testing.T) {
+	result, err := From([]int{3, 1, 2, 1, 3, 4}).Mode().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{3, 1}, result)
+}
+
+func TestModeSingle(t *testing.T) {
+	result, err := From([]string{"x", "y", "y"}).Mode().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"y"}, result)
+}
+
+func TestModeEmptyData(t *testing.T) {
+	result, err := From([]string{}).Mode().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{}, result)
+}
+
+func TestMostFrequent(t *testing.T) {
+	result, err := From([]string{"pear", "apple", "plum", "avocado", "banana", "peach"}).
+		MostFrequent(func(each string) string {
+			return each[:1]
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []KeyValue{{Key: "p", Value: 3}, {Key: "a", Value: 2}, {Key: "b", Value: 1}}, result.(*OrderedMap).Pairs())
+}
+
+func TestMostFrequentWithoutIteratee(t *testing.T) {
+	result, err := From([]int{1, 2, 2, 3, 3, 3}).MostFrequent().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{3, 2, 1}, result.(*OrderedMap).Keys())
+}
+
 func TestNth(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra"}
 	result, err := From(data).Nth(1).ResultAndError()
@@ -2489,6 +4439,90 @@ func TestOrderNotAsyncSort(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestOrderDescendingString(t *testing.T) {
+	data := []string{"grayson", "tim", "damian", "jason", "barbara"}
+	result, err := From(data).
+		OrderBy(func(each string) string {
+			return each
+		}, false).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"tim", "jason", "grayson", "damian", "barbara"}, result)
+	assert.EqualValues(t, []string{"grayson", "tim", "damian", "jason", "barbara"}, data)
+}
+
+func TestOrderDescendingFloatNotAsyncSort(t *testing.T) {
+	data := []float64{2.5, -1, 10.25, 0, 3}
+	result, err := From(data).
+		OrderBy(func(each float64) float64 {
+			return each
+		}, false, false).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{10.25, 3, 2.5, 0, -1}, result)
+}
+
+func TestOrderDescendingAsyncAndNotAsyncSortAreSame(t *testing.T) {
+	data := []int{5, 3, 9, 1, 3, 7, 2, 8, 5, 0}
+
+	resultAsync, err := From(data).OrderBy(func(each int) int {
+		return each
+	}, false, true).ResultAndError()
+	assert.Nil(t, err)
+
+	resultNotAsync, err := From(data).OrderBy(func(each int) int {
+		return each
+	}, false, false).ResultAndError()
+	assert.Nil(t, err)
+
+	assert.EqualValues(t, []int{9, 8, 7, 5, 5, 3, 3, 2, 1, 0}, resultAsync)
+	assert.EqualValues(t, resultAsync, resultNotAsync)
+}
+
+func TestOrderAscendingStable(t *testing.T) {
+	type Pair struct {
+		Key   string
+		Value int
+	}
+
+	data := []Pair{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 1}, {"e", 2}}
+	result, err := From(data).
+		OrderBy(func(each Pair) int {
+			return each.Value
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Pair{{"b", 1}, {"d", 1}, {"a", 2}, {"c", 2}, {"e", 2}}, result)
+}
+
+func TestOrderDescendingSingleElement(t *testing.T) {
+	result, err := From([]int{4}).
+		OrderBy(func(each int) int {
+			return each
+		}, false).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{4}, result)
+}
+
+func TestPairwise(t *testing.T) {
+	result, err := From([]string{"a", "b", "c"}).Pairwise().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{{"a", "b"}, {"b", "c"}}, result)
+}
+
+func TestPairwiseSingleElement(t *testing.T) {
+	result, err := From([]int{1}).Pairwise().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{}, result)
+}
+
 func TestPartition(t *testing.T) {
 	type HashMap map[string]interface{}
 
@@ -2511,19 +4545,107 @@ func TestPartition(t *testing.T) {
 
 	assert.Nil(t, err)
 
-	assert.EqualValues(t, []HashMap{
-		{"name": "grayson", "isMale": true},
-		{"name": "jason", "isMale": true},
-		{"name": "tim", "isMale": true},
-		{"name": "damian", "isMale": true},
-		{"name": "duke", "isMale": true},
-	}, resultTruthy)
+	assert.EqualValues(t, []HashMap{
+		{"name": "grayson", "isMale": true},
+		{"name": "jason", "isMale": true},
+		{"name": "tim", "isMale": true},
+		{"name": "damian", "isMale": true},
+		{"name": "duke", "isMale": true},
+	}, resultTruthy)
+
+	assert.EqualValues(t, []HashMap{
+		{"name": "barbara", "isMale": false},
+		{"name": "cassandra", "isMale": false},
+		{"name": "stephanie", "isMale": false},
+	}, resultFalsey)
+}
+
+func TestClassify(t *testing.T) {
+	type Ticket struct {
+		ID       int
+		Priority string
+		Amount   int
+	}
+
+	data := []Ticket{
+		{1, "high", 50},
+		{2, "low", 1200},
+		{3, "high", 3000},
+		{4, "medium", 10},
+		{5, "low", 20},
+	}
+
+	result := From(data).
+		Classify(func(each Ticket) bool {
+			return each.Priority == "high"
+		}, func(each Ticket, i int) bool {
+			return each.Amount > 1000
+		}, Expr(`Priority == "medium"`))
+
+	assert.Nil(t, result.Error())
+	assert.Equal(t, 4, result.Len())
+	assert.EqualValues(t, []Ticket{{1, "high", 50}, {3, "high", 3000}}, result.ResultAt(0))
+	assert.EqualValues(t, []Ticket{{2, "low", 1200}}, result.ResultAt(1))
+	assert.EqualValues(t, []Ticket{{4, "medium", 10}}, result.ResultAt(2))
+	assert.EqualValues(t, []Ticket{{5, "low", 20}}, result.ResultRemainder())
+	assert.Nil(t, result.ResultAt(4))
+	assert.Nil(t, result.ResultAt(-1))
+}
+
+func TestClassifyFirstMatchOnly(t *testing.T) {
+	calls := 0
+	classes, err := From([]int{1, 2, 3, 4, 5, 6}).
+		Classify(func(each int) bool {
+			return each%2 == 0
+		}, func(each int) bool {
+			calls++
+			return each%3 == 0
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+	assert.EqualValues(t, []interface{}{[]int{2, 4, 6}, []int{3}, []int{1, 5}}, classes)
+}
+
+func TestClassifyWithoutPredicate(t *testing.T) {
+	classes, err := From([]string{"damian", "grayson"}).Classify().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{[]string{"damian", "grayson"}}, classes)
+}
+
+func TestClassifyEmptyData(t *testing.T) {
+	result := From([]int{}).Classify(func(each int) bool {
+		return each > 0
+	})
+
+	assert.Nil(t, result.Error())
+	assert.EqualValues(t, []int{}, result.ResultAt(0))
+	assert.EqualValues(t, []int{}, result.ResultRemainder())
+}
+
+func TestClassifyInvalidPredicate(t *testing.T) {
+	result := From([]int{1, 2, 3}).Classify(func(each int) bool {
+		return each > 1
+	}, func(each int) int {
+		return each
+	})
+
+	assert.True(t, result.IsError())
+	assert.Contains(t, result.Error().Error(), "predicate 1: ")
+	assert.Equal(t, Operation(OperationClassify), result.LastErrorOperation())
+	assert.Equal(t, 0, result.Len())
+	assert.Nil(t, result.ResultRemainder())
+}
+
+func TestClassifyInvalidData(t *testing.T) {
+	result := From(12).Classify(func(each int) bool {
+		return each > 1
+	})
 
-	assert.EqualValues(t, []HashMap{
-		{"name": "barbara", "isMale": false},
-		{"name": "cassandra", "isMale": false},
-		{"name": "stephanie", "isMale": false},
-	}, resultFalsey)
+	assert.True(t, result.IsError())
+	assert.Nil(t, result.Result())
 }
 
 func TestExcludeOneData(t *testing.T) {
@@ -2662,6 +4784,131 @@ func TestReduceCollection(t *testing.T) {
 	)
 }
 
+func TestReduceSliceStopIteration(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	visited := make([]int, 0)
+	result, err := From(data).
+		Reduce(func(current, each int, i int) (int, error) {
+			visited = append(visited, i)
+			if current+each > 5 {
+				return current, ErrStopIteration
+			}
+
+			return current + each, nil
+		}, 0).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, result)
+	assert.EqualValues(t, []int{0, 1, 2}, visited)
+}
+
+func TestReduceSliceIterateeError(t *testing.T) {
+	data := []int{1, 2, 3}
+	_, err := From(data).
+		Reduce(func(current, each int) (int, error) {
+			if each == 2 {
+				return current, errors.New("unexpected element")
+			}
+
+			return current + each, nil
+		}, 0).
+		ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "unexpected element")
+}
+
+func TestReduceSliceInvalidIterateeOutput(t *testing.T) {
+	data := []int{1, 2, 3}
+	_, err := From(data).
+		Reduce(func(current, each int) (int, bool) {
+			return current + each, true
+		}, 0).
+		ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "callback return value should only be 1 variable")
+}
+
+func TestReduceCollectionStopIteration(t *testing.T) {
+	data := map[string]int{"a": 1, "b": 2, "c": 3}
+	calls := 0
+	result, err := From(data).
+		Reduce(func(current, value int) (int, error) {
+			calls++
+			return current + value, ErrStopIteration
+		}, 10).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Contains(t, []int{11, 12, 13}, result)
+}
+
+func TestReduceRight(t *testing.T) {
+	data := []string{"a", "b", "c"}
+	visited := make([]int, 0)
+	result, err := From(data).
+		ReduceRight(func(current string, each string, i int) string {
+			visited = append(visited, i)
+			return current + each
+		}, "").
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "cba", result)
+	assert.EqualValues(t, []int{2, 1, 0}, visited)
+}
+
+func TestReduceRightStopIteration(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+	result, err := From(data).
+		ReduceRight(func(current, each int) (int, error) {
+			if each == 2 {
+				return current, ErrStopIteration
+			}
+
+			return current + each, nil
+		}, 0).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 7, result)
+}
+
+func TestReduceRightEmptyData(t *testing.T) {
+	result, err := From([]int{}).
+		ReduceRight(func(current, each int) int {
+			return current + each
+		}, 100).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 100, result)
+}
+
+func TestReduceRightInvalidIteratee(t *testing.T) {
+	_, err := From([]int{1, 2}).
+		ReduceRight(func(current string, each int) string {
+			return current
+		}, 0).
+		ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "callback 1st parameter's data type should be same with initial value's data type")
+}
+
+func TestReduceRightCollection(t *testing.T) {
+	_, err := From(map[string]int{"a": 1}).
+		ReduceRight(func(current, each int) int {
+			return current + each
+		}, 0).
+		ResultAndError()
+
+	assert.NotNil(t, err)
+}
+
 func TestReject(t *testing.T) {
 	type Book struct {
 		EbookName      string
@@ -2688,6 +4935,93 @@ func TestReject(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestResample(t *testing.T) {
+	type Metric struct {
+		At    time.Time
+		Value float64
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	data := []Metric{
+		{At: start.Add(10 * time.Second), Value: 1},
+		{At: start.Add(50 * time.Second), Value: 3},
+		{At: start.Add(3*time.Minute + 5*time.Second), Value: 10},
+	}
+
+	result, err := From(data).
+		Resample(time.Minute, func(each Metric) time.Time {
+			return each.At
+		}, func(bucket time.Time, items []Metric) Metric {
+			sum := 0.0
+			for _, each := range items {
+				sum += each.Value
+			}
+
+			return Metric{At: bucket, Value: sum}
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Metric{
+		{At: start, Value: 4},
+		{At: start.Add(time.Minute), Value: 0},
+		{At: start.Add(2 * time.Minute), Value: 0},
+		{At: start.Add(3 * time.Minute), Value: 10},
+	}, result)
+}
+
+func TestResamplePointerToSlice(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	data := []time.Time{start, start.Add(30 * time.Second), start.Add(2 * time.Minute)}
+
+	result, err := From(&data).
+		Resample(time.Minute, nil, func(bucket time.Time, items []time.Time) int {
+			return len(items)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.IsType(t, []int{}, result)
+}
+
+func TestResampleEmptyData(t *testing.T) {
+	result, err := From([]time.Time{}).
+		Resample(time.Hour, nil, func(start time.Time, items []time.Time) int {
+			return len(items)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{}, result)
+}
+
+func TestResampleInvalidAggregator(t *testing.T) {
+	type Metric struct {
+		At    time.Time
+		Value float64
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	data := []Metric{
+		{At: start, Value: 1},
+		{At: start.Add(time.Minute), Value: 2},
+		{At: start.Add(2 * time.Minute), Value: 4},
+		{At: start.Add(5 * time.Minute), Value: 8},
+	}
+
+	_, err := From(data).
+		Resample(time.Hour, func(each Metric) time.Time {
+			return each.At
+		}, func(items []Metric) int {
+			return len(items)
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "aggregator parameter should be (time.Time, []gubrak.Metric)")
+}
+
 func TestReverse(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra"}
 	result, err := From(data).Reverse().ResultAndError()
@@ -2704,6 +5038,166 @@ func TestReverseWithEmptyData(t *testing.T) {
 	assert.EqualValues(t, []string{}, result)
 }
 
+func TestRightJoin(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	result, err := From(orders).
+		RightJoin(customers, orderKey, customerKey, func(order *Order, customer Customer) string {
+			if order == nil {
+				return fmt.Sprintf("-:%s", customer.Name)
+			}
+
+			return fmt.Sprintf("%d:%s", order.ID, customer.Name)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"2:bob", "1:alice", "3:alice", "-:carol"}, result)
+}
+
+func TestRolling(t *testing.T) {
+	result, err := From([]int{2, 4, 6, 8}).
+		Rolling(3, func(window []int) float64 {
+			sum := 0
+			for _, each := range window {
+				sum += each
+			}
+
+			return float64(sum) / float64(len(window))
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{2, 3, 4, 6}, result)
+}
+
+func TestRollingWindowIsIsolated(t *testing.T) {
+	data := []int{1, 2, 3}
+	result, err := From(data).
+		Rolling(2, func(window []int) []int {
+			return append(window, 0)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 0}, {1, 2, 0}, {2, 3, 0}}, result)
+	assert.EqualValues(t, []int{1, 2, 3}, data)
+}
+
+func TestRollingInvalidSize(t *testing.T) {
+	_, err := From([]int{1}).
+		Rolling(0, func(window []int) int {
+			return len(window)
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "size should be greater than zero")
+}
+
+func TestRollingInvalidReducer(t *testing.T) {
+	_, err := From([]int{1}).
+		Rolling(1, func(window []string) int {
+			return len(window)
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "reducer parameter should be ([]int)")
+}
+
+func TestRollingBy(t *testing.T) {
+	type Metric struct {
+		At    time.Time
+		Value float64
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	data := []Metric{
+		{At: start, Value: 1},
+		{At: start.Add(time.Minute), Value: 2},
+		{At: start.Add(2 * time.Minute), Value: 4},
+		{At: start.Add(5 * time.Minute), Value: 8},
+	}
+
+	result, err := From(data).
+		RollingBy(2*time.Minute, func(each Metric) time.Time {
+			return each.At
+		}, func(window []Metric) int {
+			return len(window)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2, 2, 1}, result)
+}
+
+func TestRollingByUnsortedData(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := From([]time.Time{start.Add(time.Minute), start}).
+		RollingBy(time.Minute, nil, func(window []time.Time) int {
+			return len(window)
+		}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "data should be sorted by time in ascending order")
+}
+
+func TestRotate(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+
+	result, err := From(data).Rotate(2).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{3, 4, 5, 1, 2}, result)
+
+	result, err = From(data).Rotate(-2).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{4, 5, 1, 2, 3}, result)
+
+	result, err = From(data).Rotate(12).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{3, 4, 5, 1, 2}, result)
+
+	assert.EqualValues(t, []int{1, 2, 3, 4, 5}, data)
+}
+
+func TestRotateEmptyData(t *testing.T) {
+	result, err := From([]string{}).Rotate(3).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{}, result)
+}
+
 func TestSample(t *testing.T) {
 	type Book struct {
 		EbookName      string
@@ -2760,6 +5254,109 @@ func TestSampleSize(t *testing.T) {
 	}
 }
 
+func TestScan(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+	result, err := From(data).
+		Scan(func(current, each int) int {
+			return current + each
+		}, 10).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{11, 13, 16, 20}, result)
+}
+
+func TestScanDifferentAccumulatorType(t *testing.T) {
+	data := []string{"damian", "grayson", "tim"}
+	result, err := From(data).
+		Scan(func(current float64, each string, i int) float64 {
+			return current + float64(len(each))
+		}, 0.0).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{6, 13, 16}, result)
+}
+
+func TestScanStopIteration(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+	result, err := From(data).
+		Scan(func(current, each int) (int, error) {
+			if each == 3 {
+				return current * 10, ErrStopIteration
+			}
+
+			return current + each, nil
+		}, 0).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 3, 30}, result)
+}
+
+func TestScanEmptyData(t *testing.T) {
+	result, err := From([]int{}).
+		Scan(func(current, each int) int {
+			return current + each
+		}, 0).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{}, result)
+}
+
+func TestScanInvalidIteratee(t *testing.T) {
+	_, err := From([]int{1, 2}).
+		Scan(func(current int, each string) int {
+			return current
+		}, 0).
+		ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "callback 2nd parameter's data type should be same with slice element data type")
+}
+
+func TestSemiJoin(t *testing.T) {
+	type Order struct {
+		ID         int
+		CustomerID int
+		Amount     int
+	}
+
+	type Customer struct {
+		ID   int
+		Name string
+	}
+
+	orders := []Order{
+		{ID: 1, CustomerID: 10, Amount: 100},
+		{ID: 2, CustomerID: 20, Amount: 200},
+		{ID: 3, CustomerID: 10, Amount: 300},
+		{ID: 4, CustomerID: 99, Amount: 400},
+	}
+
+	customers := []Customer{
+		{ID: 20, Name: "bob"},
+		{ID: 10, Name: "alice"},
+		{ID: 30, Name: "carol"},
+	}
+
+	orderKey := func(each Order) int {
+		return each.CustomerID
+	}
+
+	customerKey := func(each Customer) int {
+		return each.ID
+	}
+
+	result, err := From(customers).
+		SemiJoin(orders, customerKey, orderKey).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Customer{{ID: 20, Name: "bob"}, {ID: 10, Name: "alice"}}, result)
+}
+
 func TestShuffle(t *testing.T) {
 	type Book struct {
 		EbookName      string
@@ -2818,6 +5415,167 @@ func TestSizeCollection(t *testing.T) {
 	assert.EqualValues(t, len(data), result)
 }
 
+func TestSplitInto(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4, 5, 6, 7}).SplitInto(3).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 2, 3}, {4, 5}, {6, 7}}, result)
+}
+
+func TestSplitIntoMorePartsThanData(t *testing.T) {
+	result, err := From([]string{"a", "b"}).SplitInto(4).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{{"a"}, {"b"}, {}, {}}, result)
+}
+
+func TestSplitIntoInvalidN(t *testing.T) {
+	_, err := From([]int{1}).SplitInto(0).ResultAndError()
+
+	assert.EqualError(t, err, "n should be greater than zero")
+}
+
+func TestSplitWhen(t *testing.T) {
+	result, err := From([]int{1, 2, 6, 7, 8, 3, 4}).
+		SplitWhen(func(previous, current int) bool {
+			return current < previous
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 2, 6, 7, 8}, {3, 4}}, result)
+}
+
+func TestSplitWhenArrayData(t *testing.T) {
+	result, err := From([4]string{"a", "a", "b", "c"}).
+		SplitWhen(func(previous, current string) bool {
+			return previous != current
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{{"a", "a"}, {"b"}, {"c"}}, result)
+}
+
+func TestSum(t *testing.T) {
+	result := From([]int{1, 2, 3, 4}).Sum()
+	value, ok := result.Int()
+
+	assert.Nil(t, result.Error())
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), value)
+	assert.False(t, result.IsFloat())
+	assert.Equal(t, "10", result.Result().(*big.Int).String())
+}
+
+func TestSumWithIteratee(t *testing.T) {
+	value, ok := From([]string{"a", "bb", "ccc"}).
+		Sum(func(each string) int {
+			return len(each)
+		}).
+		Int()
+
+	assert.True(t, ok)
+	assert.Equal(t, int64(6), value)
+}
+
+func TestSumIntegerOverflow(t *testing.T) {
+	value, ok := From([]int64{math.MaxInt64, math.MaxInt64, -math.MaxInt64}).Sum().Int()
+
+	assert.True(t, ok)
+	assert.Equal(t, int64(math.MaxInt64), value)
+}
+
+func TestSumIntegerPrecision(t *testing.T) {
+	value, ok := From([]int64{1 << 53, 1}).Sum().Int()
+
+	assert.True(t, ok)
+	assert.Equal(t, int64(9007199254740993), value)
+}
+
+func TestSumDoesNotFitInt64(t *testing.T) {
+	result := From([]int64{math.MaxInt64, 1}).Sum()
+	_, ok := result.Int()
+
+	assert.Nil(t, result.Error())
+	assert.False(t, ok)
+	assert.Equal(t, "9223372036854775808", result.Big().String())
+	assert.Equal(t, float64(1<<63), result.Float())
+}
+
+func TestSumUnsigned(t *testing.T) {
+	result := From([]uint64{math.MaxUint64, 1}).Sum()
+	_, ok := result.Int()
+
+	assert.Nil(t, result.Error())
+	assert.False(t, ok)
+	assert.Equal(t, "18446744073709551616", result.Big().String())
+
+	value, ok := From([]uint8{200, 100}).Sum().Int()
+
+	assert.True(t, ok)
+	assert.Equal(t, int64(300), value)
+}
+
+func TestSumFloatCompensated(t *testing.T) {
+	result := From([]float64{1, 1e100, 1, -1e100}).Sum()
+
+	assert.Nil(t, result.Error())
+	assert.True(t, result.IsFloat())
+	assert.Equal(t, float64(2), result.Float())
+	assert.Equal(t, float64(2), result.Result())
+}
+
+func TestSumEmptyData(t *testing.T) {
+	value, ok := From([]int{}).Sum().Int()
+
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), value)
+}
+
+func TestSumFloatIteratee(t *testing.T) {
+	result := From([]int{1, 2}).
+		Sum(func(each int) float64 {
+			return float64(each) / 2
+		})
+	_, ok := result.Int()
+
+	assert.Nil(t, result.Error())
+	assert.False(t, ok)
+	assert.Equal(t, float64(1.5), result.Float())
+	assert.Equal(t, "1", result.Big().String())
+}
+
+func TestSumFloatIntegral(t *testing.T) {
+	value, ok := From([]float64{1.5, 2.5}).Sum().Int()
+
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), value)
+}
+
+func TestSumNotNumber(t *testing.T) {
+	result := From([]string{"a"}).Sum()
+	_, ok := result.Int()
+
+	assert.False(t, ok)
+	assert.Nil(t, result.Big())
+	assert.EqualError(t, result.Error(), "element data type should be number, or iteratee should be provided")
+}
+
+func TestSumBig(t *testing.T) {
+	result, err := From([]float64{0.1, 0.2}).SumBig().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, "3/10", result.(*big.Rat).String())
+}
+
+func TestSumBigIntegerOverflow(t *testing.T) {
+	result, err := From([]uint64{math.MaxUint64, math.MaxUint64}).SumBig().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, "36893488147419103230/1", result.(*big.Rat).String())
+}
+
 func TestTail(t *testing.T) {
 	data := []string{"damian", "grayson", "cassandra"}
 	result, err := From(data).Tail().ResultAndError()
@@ -2842,6 +5600,96 @@ func TestTakeRight(t *testing.T) {
 	assert.EqualValues(t, []string{"grayson", "cassandra"}, result)
 }
 
+func TestTakeWhile(t *testing.T) {
+	data := []int{1, 2, 3, 4, 1, 2}
+	visited := make([]int, 0)
+	result, err := From(data).TakeWhile(func(each int, i int) bool {
+		visited = append(visited, i)
+		return each < 3
+	}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2}, result)
+	assert.EqualValues(t, []int{0, 1, 2}, visited)
+}
+
+func TestTakeWhileExprStopsAtFirstFailingElement(t *testing.T) {
+	type Item struct {
+		Age int
+	}
+
+	// evaluating the last element would fail with division by zero
+	data := []Item{{Age: 1}, {Age: 2}, {Age: 20}, {Age: 0}}
+	result, err := From(data).TakeWhile(Expr("10 / Age > 1")).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Item{{Age: 1}, {Age: 2}}, result)
+
+	result, err = From(data).DropWhile(Expr("10 / Age > 1")).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Item{{Age: 20}, {Age: 0}}, result)
+}
+
+func TestTakeRightWhileExprStopsAtFirstFailingElement(t *testing.T) {
+	type Item struct {
+		Age int
+	}
+
+	// evaluating the first element would fail with division by zero
+	data := []Item{{Age: 0}, {Age: 20}, {Age: 2}, {Age: 1}}
+	result, err := From(data).TakeRightWhile(Expr("10 / Age > 1")).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Item{{Age: 2}, {Age: 1}}, result)
+
+	result, err = From(data).DropRightWhile(Expr("10 / Age > 1")).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Item{{Age: 0}, {Age: 20}}, result)
+}
+
+func TestTakeWhileEmpty(t *testing.T) {
+	data := []string{}
+	result, err := From(data).TakeWhile(func(each string) bool {
+		return true
+	}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{}, result)
+}
+
+func TestTakeWhileNotSlice(t *testing.T) {
+	_, err := From(map[string]int{"a": 1}).TakeWhile(func(each int) bool {
+		return true
+	}).ResultAndError()
+
+	assert.NotNil(t, err)
+}
+
+func TestTakeRightWhile(t *testing.T) {
+	data := []string{"damian", "grayson", "cassandra", "tim"}
+	visited := make([]int, 0)
+	result, err := From(data).TakeRightWhile(func(each string, i int) bool {
+		visited = append(visited, i)
+		return each != "grayson"
+	}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"cassandra", "tim"}, result)
+	assert.EqualValues(t, []int{3, 2, 1}, visited)
+}
+
+func TestTakeRightWhileAll(t *testing.T) {
+	data := []int{1, 2, 3}
+	result, err := From(data).TakeRightWhile(func(each int) bool {
+		return each > 0
+	}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2, 3}, result)
+}
+
 func TestUnionMany1(t *testing.T) {
 	data := []string{"damian", "grayson", "grayson", "cassandra"}
 	union := []string{"tim", "grayson", "jason", "stephanie"}
@@ -2870,6 +5718,118 @@ func TestUnionManyDifferentDataType(t *testing.T) {
 	assert.EqualError(t, err, "data type of each elements between slice must be same")
 }
 
+func TestTopK(t *testing.T) {
+	type player struct {
+		Name  string
+		Score int
+	}
+
+	data := []player{
+		{Name: "a", Score: 10},
+		{Name: "b", Score: 50},
+		{Name: "c", Score: 30},
+		{Name: "d", Score: 50},
+		{Name: "e", Score: 20},
+	}
+
+	result, err := From(data).
+		TopK(3, func(each player) int {
+			return each.Score
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []player{{Name: "b", Score: 50}, {Name: "d", Score: 50}, {Name: "c", Score: 30}}, result)
+}
+
+func TestTopKWithoutIteratee(t *testing.T) {
+	result, err := From([]string{"kiwi", "apple", "mango", "banana"}).TopK(2, nil).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"mango", "kiwi"}, result)
+}
+
+func TestTopKGreaterThanData(t *testing.T) {
+	result, err := From([]float64{1.5, 3.5, 2.5}).TopK(10, nil).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{3.5, 2.5, 1.5}, result)
+}
+
+func TestTopKZero(t *testing.T) {
+	result, err := From([]int{1, 2, 3}).TopK(0, nil).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{}, result)
+}
+
+func TestTopKNegative(t *testing.T) {
+	_, err := From([]int{1, 2, 3}).TopK(-1, nil).ResultAndError()
+
+	assert.EqualError(t, err, "k must not be negative number")
+}
+
+func TestTopKIncomparable(t *testing.T) {
+	_, err := From([][]int{{1}, {2}}).TopK(1, nil).ResultAndError()
+
+	assert.EqualError(t, err, "cannot compare [2] with [1]")
+}
+
+func TestTranspose(t *testing.T) {
+	data := [][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	result, err := From(data).Transpose().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 4}, {2, 5}, {3, 6}}, result)
+}
+
+func TestTransposeRaggedRows(t *testing.T) {
+	data := [][]string{
+		{"a", "b", "c"},
+		{"d"},
+		{"e", "f"},
+	}
+
+	result, err := From(data).Transpose().ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{{"a", "d", "e"}}, result)
+
+	result, err = From(data).Transpose(ZipPad).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{{"a", "d", "e"}, {"b", "", "f"}, {"c", "", ""}}, result)
+
+	_, err = From(data).Transpose(ZipReturnError).ResultAndError()
+	assert.EqualError(t, err, "length of row 1 should be same with length of row 0")
+}
+
+func TestTransposeInterfaceMatrix(t *testing.T) {
+	data := [][]interface{}{
+		{"a", 1},
+		{"b", 2},
+	}
+	result, err := From(data).Transpose().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]interface{}{{"a", "b"}, {1, 2}}, result)
+}
+
+func TestTransposeEmptyData(t *testing.T) {
+	result, err := From([][]int{}).Transpose().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{}, result)
+}
+
+func TestTransposeNotMatrix(t *testing.T) {
+	_, err := From([]int{1, 2}).Transpose().ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "data element should be slice")
+}
+
 func TestUniq(t *testing.T) {
 	data := []string{"damian", "grayson", "grayson", "cassandra"}
 	result, err := From(data).Uniq().ResultAndError()
@@ -2885,3 +5845,168 @@ func TestUniq(t *testing.T) {
 // 	assert.Nil(t, err)
 // 	assert.EqualValues(t, []string{"damian", "jason"}, result)
 // }
+
+func TestUnzip(t *testing.T) {
+	result, err := From([][]int{{1, 10}, {2, 20}, {3, 30}}).Unzip().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 2, 3}, {10, 20, 30}}, result)
+}
+
+func TestUnzipInterfaceRows(t *testing.T) {
+	rows := [][]interface{}{
+		{"damian", 13, nil},
+		{"grayson", 30, 1.5},
+	}
+
+	result, err := From(rows).Unzip().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{
+		[]string{"damian", "grayson"},
+		[]int{13, 30},
+		[]interface{}{nil, 1.5},
+	}, result)
+}
+
+func TestUnzipMixedColumn(t *testing.T) {
+	result, err := From([][]interface{}{{1}, {"a"}}).Unzip().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{[]interface{}{1, "a"}}, result)
+}
+
+func TestUnzipIsInverseOfZip(t *testing.T) {
+	names := []string{"damian", "grayson"}
+	ages := []int{13, 30}
+
+	result, err := From(names).Zip(ages).Unzip().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []interface{}{names, ages}, result)
+}
+
+func TestUnzipPolicy(t *testing.T) {
+	rows := [][]int{{1, 2, 3}, {4}}
+
+	result, err := From(rows).Unzip().ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 4}}, result)
+
+	result, err = From(rows).Unzip(ZipPad).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 4}, {2, 0}, {3, 0}}, result)
+
+	_, err = From(rows).Unzip(ZipReturnError).ResultAndError()
+	assert.EqualError(t, err, "length of row 1 should be same with length of row 0")
+}
+
+func TestUnzipInvalidRow(t *testing.T) {
+	_, err := From([]interface{}{[]int{1}, 2}).Unzip().ResultAndError()
+
+	assert.EqualError(t, err, "row 1 should be slice")
+}
+
+func TestWindow(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4, 5}).Window(3, 1).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, result)
+}
+
+func TestWindowWithStep(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4, 5, 6}).Window(2, 3).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 2}, {4, 5}}, result)
+}
+
+func TestWindowBiggerThanData(t *testing.T) {
+	result, err := From([]int{1, 2}).Window(3, 1).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{}, result)
+}
+
+func TestWindowIsCopy(t *testing.T) {
+	data := []int{1, 2, 3}
+	result := From(data).Window(2, 1).Result().([][]int)
+	result[0][1] = 9
+
+	assert.EqualValues(t, []int{1, 2, 3}, data)
+	assert.EqualValues(t, []int{2, 3}, result[1])
+}
+
+func TestWindowInvalidSize(t *testing.T) {
+	_, err := From([]int{1}).Window(0, 1).ResultAndError()
+	assert.EqualError(t, err, "size should be greater than zero")
+
+	_, err = From([]int{1}).Window(1, -1).ResultAndError()
+	assert.EqualError(t, err, "step should be greater than zero")
+}
+
+func TestZip(t *testing.T) {
+	result, err := From([]string{"damian", "grayson"}).Zip([]int{13, 30}, []bool{true, false}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]interface{}{{"damian", 13, true}, {"grayson", 30, false}}, result)
+}
+
+func TestZipSameType(t *testing.T) {
+	result, err := From([]float64{1, 2}).Zip([]float64{10, 20}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]float64{{1, 10}, {2, 20}}, result)
+}
+
+func TestZipPolicy(t *testing.T) {
+	result, err := From([]int{1, 2, 3}).Zip([]string{"a"}).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]interface{}{{1, "a"}}, result)
+
+	result, err = From([]int{1, 2, 3}).Zip([]string{"a"}, ZipPad).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]interface{}{{1, "a"}, {2, ""}, {3, ""}}, result)
+
+	_, err = From([]int{1, 2, 3}).Zip([]int{4, 5, 6}, []string{"a"}, ZipReturnError).ResultAndError()
+	assert.EqualError(t, err, "length of zip data 2 should be same with length of data")
+}
+
+func TestZipWithoutOtherSlices(t *testing.T) {
+	result, err := From([]int{1, 2}).Zip().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1}, {2}}, result)
+}
+
+func TestZipInvalidData(t *testing.T) {
+	_, err := From([]int{1}).Zip(1).ResultAndError()
+
+	assert.EqualError(t, err, "zip data 1 must be slice")
+}
+
+func TestZipWith(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	result, err := From([]string{"damian", "grayson", "jason"}).
+		ZipWith(func(name string, age int) Person {
+			return Person{Name: name, Age: age}
+		}, []int{13, 30}, ZipPad).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Person{{Name: "damian", Age: 13}, {Name: "grayson", Age: 30}, {Name: "jason"}}, result)
+}
+
+func TestZipWithInvalidConstructor(t *testing.T) {
+	_, err := From([]string{"damian"}).
+		ZipWith(func(name string) string {
+			return name
+		}, []int{13}).
+		ResultAndError()
+
+	assert.EqualError(t, err, "constructor parameter should be (string, int)")
+}
@@ -1,63 +1,156 @@
 package gubrak
 
+import (
+	"time"
+
+	"github.com/novalagung/gubrak/v2/stats"
+)
+
 // Operation represent the type of chainable operation
 type Operation string
 
 const (
-	OperationNone             = ""
-	OperationChunk            = "Chunk()"
-	OperationCompact          = "Compact()"
-	OperationConcatMany       = "ConcatMany()"
-	OperationConcat           = "Concat()"
-	OperationContains         = "Contains()"
-	OperationCountBy          = "CountBy()"
-	OperationCount            = "Count()"
-	OperationDifferenceMany   = "DifferenceMany()"
-	OperationDifference       = "Difference()"
-	OperationDrop             = "Drop()"
-	OperationDropRight        = "DropRight()"
-	OperationEach             = "Each()"
-	OperationEachRight        = "EachRight()"
-	OperationExclude          = "Exclude()"
-	OperationExcludeMany      = "ExcludeMany()"
-	OperationExcludeAt        = "ExcludeAt()"
-	OperationExcludeAtMany    = "ExcludeAtMany()"
-	OperationForEach          = "ForEach()"
-	OperationForEachRight     = "ForEachRight()"
-	OperationFill             = "Fill()"
-	OperationFilter           = "Filter()"
-	OperationFind             = "Find()"
-	OperationFindIndex        = "FindIndex()"
-	OperationFindLast         = "FindLast()"
-	OperationFindLastIndex    = "FindLastIndex()"
-	OperationFirst            = "First()"
-	OperationHead             = "Head()"
-	OperationFromPairs        = "FromPairs()"
-	OperationGroupBy          = "GroupBy()"
-	OperationIndexOf          = "IndexOf()"
-	OperationInitial          = "Initial()"
-	OperationIntersection     = "Intersection()"
-	OperationIntersectionMany = "IntersectionMany()"
-	OperationJoin             = "Join()"
-	OperationKeyBy            = "KeyBy()"
-	OperationLast             = "Last()"
-	OperationLastIndexOf      = "LastIndexOf()"
-	OperationMap              = "Map()"
-	OperationNth              = "Nth()"
-	OperationOrderBy          = "OrderBy()"
-	OperationPartition        = "Partition()"
-	OperationReduce           = "Reduce()"
-	OperationReject           = "Reject()"
-	OperationReverse          = "Reverse()"
-	OperationSample           = "Sample()"
-	OperationSampleSize       = "SampleSize()"
-	OperationShuffle          = "Shuffle()"
-	OperationSize             = "Size()"
-	OperationTail             = "Tail()"
-	OperationTake             = "Take()"
-	OperationTakeRight        = "TakeRight()"
-	OperationUniq             = "Uniq()"
-	OperationUnionMany        = "UnionMany()"
+	OperationNone                        = ""
+	OperationAggregate                   = "Aggregate()"
+	OperationAntiJoin                    = "AntiJoin()"
+	OperationAvg                         = "Avg()"
+	OperationBinByTime                   = "BinByTime()"
+	OperationBucketize                   = "Bucketize()"
+	OperationChunk                       = "Chunk()"
+	OperationChunkByWeight               = "ChunkByWeight()"
+	OperationChunkWhile                  = "ChunkWhile()"
+	OperationClassify                    = "Classify()"
+	OperationCombinations                = "Combinations()"
+	OperationCombinationsWithReplacement = "CombinationsWithReplacement()"
+	OperationCompact                     = "Compact()"
+	OperationConcatMany                  = "ConcatMany()"
+	OperationConcat                      = "Concat()"
+	OperationContains                    = "Contains()"
+	OperationCountBy                     = "CountBy()"
+	OperationCountByKey                  = "CountByKey()"
+	OperationCountByOrdered              = "CountByOrdered()"
+	OperationCount                       = "Count()"
+	OperationCorrelation                 = "Correlation()"
+	OperationCovariance                  = "Covariance()"
+	OperationCumulativeSum               = "CumulativeSum()"
+	OperationDiff                        = "Diff()"
+	OperationDifferenceMany              = "DifferenceMany()"
+	OperationDifference                  = "Difference()"
+	OperationDrop                        = "Drop()"
+	OperationDropRight                   = "DropRight()"
+	OperationDropRightWhile              = "DropRightWhile()"
+	OperationDropWhile                   = "DropWhile()"
+	OperationEach                        = "Each()"
+	OperationEachRight                   = "EachRight()"
+	OperationExclude                     = "Exclude()"
+	OperationExcludeMany                 = "ExcludeMany()"
+	OperationExcludeAt                   = "ExcludeAt()"
+	OperationExcludeAtMany               = "ExcludeAtMany()"
+	OperationForEach                     = "ForEach()"
+	OperationForEachRight                = "ForEachRight()"
+	OperationFill                        = "Fill()"
+	OperationFillBackward                = "FillBackward()"
+	OperationFillForward                 = "FillForward()"
+	OperationFilter                      = "Filter()"
+	OperationFilterGroups                = "FilterGroups()"
+	OperationFind                        = "Find()"
+	OperationFindIndex                   = "FindIndex()"
+	OperationFindLast                    = "FindLast()"
+	OperationFindLastIndex               = "FindLastIndex()"
+	OperationFirst                       = "First()"
+	OperationFlatMap                     = "FlatMap()"
+	OperationFlatten                     = "Flatten()"
+	OperationFlattenDeep                 = "FlattenDeep()"
+	OperationFlattenDepth                = "FlattenDepth()"
+	OperationFrequencies                 = "Frequencies()"
+	OperationHead                        = "Head()"
+	OperationFromPairs                   = "FromPairs()"
+	OperationFullOuterJoin               = "FullOuterJoin()"
+	OperationGroupBy                     = "GroupBy()"
+	OperationGroupByMany                 = "GroupByMany()"
+	OperationGroupByManyOrdered          = "GroupByManyOrdered()"
+	OperationGroupByOrdered              = "GroupByOrdered()"
+	OperationHistogram                   = "Histogram()"
+	OperationHistogramEdges              = "HistogramEdges()"
+	OperationIndexOf                     = "IndexOf()"
+	OperationInitial                     = "Initial()"
+	OperationInnerJoin                   = "InnerJoin()"
+	OperationInterleave                  = "Interleave()"
+	OperationIntersection                = "Intersection()"
+	OperationIntersectionMany            = "IntersectionMany()"
+	OperationIntersperse                 = "Intersperse()"
+	OperationIsSorted                    = "IsSorted()"
+	OperationJoin                        = "Join()"
+	OperationKeyBy                       = "KeyBy()"
+	OperationKeyByOrdered                = "KeyByOrdered()"
+	OperationLast                        = "Last()"
+	OperationLastIndexOf                 = "LastIndexOf()"
+	OperationLeftJoin                    = "LeftJoin()"
+	OperationMap                         = "Map()"
+	OperationMapGroups                   = "MapGroups()"
+	OperationMax                         = "Max()"
+	OperationMaxBy                       = "MaxBy()"
+	OperationMean                        = "Mean()"
+	OperationMedian                      = "Median()"
+	OperationMergeSorted                 = "MergeSorted()"
+	OperationMin                         = "Min()"
+	OperationMinBy                       = "MinBy()"
+	OperationMode                        = "Mode()"
+	OperationMostFrequent                = "MostFrequent()"
+	OperationNth                         = "Nth()"
+	OperationOrderBy                     = "OrderBy()"
+	OperationPairwise                    = "Pairwise()"
+	OperationPartition                   = "Partition()"
+	OperationPercentile                  = "Percentile()"
+	OperationPermutations                = "Permutations()"
+	OperationPowerSet                    = "PowerSet()"
+	OperationProduct                     = "Product()"
+	OperationQuantile                    = "Quantile()"
+	OperationReduce                      = "Reduce()"
+	OperationReduceRight                 = "ReduceRight()"
+	OperationReject                      = "Reject()"
+	OperationResample                    = "Resample()"
+	OperationReverse                     = "Reverse()"
+	OperationRightJoin                   = "RightJoin()"
+	OperationRolling                     = "Rolling()"
+	OperationRollingBy                   = "RollingBy()"
+	OperationRotate                      = "Rotate()"
+	OperationRows                        = "Rows()"
+	OperationSample                      = "Sample()"
+	OperationSampleCovariance            = "SampleCovariance()"
+	OperationSampleSize                  = "SampleSize()"
+	OperationSampleStdDev                = "SampleStdDev()"
+	OperationSampleVariance              = "SampleVariance()"
+	OperationScan                        = "Scan()"
+	OperationSemiJoin                    = "SemiJoin()"
+	OperationShuffle                     = "Shuffle()"
+	OperationSize                        = "Size()"
+	OperationSortedDifference            = "SortedDifference()"
+	OperationSortedIndex                 = "SortedIndex()"
+	OperationSortedIndexOf               = "SortedIndexOf()"
+	OperationSortedIntersection          = "SortedIntersection()"
+	OperationSortedLastIndex             = "SortedLastIndex()"
+	OperationSortedUniq                  = "SortedUniq()"
+	OperationSplitInto                   = "SplitInto()"
+	OperationSplitWhen                   = "SplitWhen()"
+	OperationStdDev                      = "StdDev()"
+	OperationSum                         = "Sum()"
+	OperationSumBig                      = "SumBig()"
+	OperationTail                        = "Tail()"
+	OperationTake                        = "Take()"
+	OperationTakeRight                   = "TakeRight()"
+	OperationTakeRightWhile              = "TakeRightWhile()"
+	OperationTakeWhile                   = "TakeWhile()"
+	OperationTopK                        = "TopK()"
+	OperationTranspose                   = "Transpose()"
+	OperationUniq                        = "Uniq()"
+	OperationUnionMany                   = "UnionMany()"
+	OperationUnzip                       = "Unzip()"
+	OperationVariance                    = "Variance()"
+	OperationWindow                      = "Window()"
+	OperationZip                         = "Zip()"
+	OperationZipWith                     = "ZipWith()"
+	OperationZScores                     = "ZScores()"
 )
 
 // IChainable is the base interface for chainable functions
@@ -76,16 +169,32 @@ type IChainable interface {
 
 // IChainableOperation is interface for chainable functions declaration
 type IChainableOperation interface {
+	AntiJoin(interface{}, interface{}, interface{}) IChainable
+	BinByTime(time.Duration, interface{}) IChainable
+	Bucketize([]float64, interface{}) IChainable
 	Chunk(int) IChainable
+	ChunkByWeight(float64, interface{}, ...OversizePolicy) IChainable
+	ChunkWhile(interface{}) IChainable
+	Classify(...interface{}) IChainableMultipleReturnValueResult
+	Combinations(int, ...CombinatoricsLimit) IChainable
+	CombinationsWithReplacement(int, ...CombinatoricsLimit) IChainable
 	Compact() IChainable
 	ConcatMany(...interface{}) IChainable
 	Concat(interface{}) IChainable
+	Correlation(interface{}, interface{}) IChainableFloatResult
 	CountBy(interface{}) IChainableNumberResult
+	CountByKey(interface{}) IChainable
+	CountByOrdered(interface{}) IChainable
 	Count() IChainableNumberResult
+	Covariance(interface{}, interface{}) IChainableFloatResult
+	CumulativeSum(...interface{}) IChainable
+	Diff(interface{}, interface{}) IChainable
 	DifferenceMany(...interface{}) IChainable
 	Difference(interface{}) IChainable
 	Drop(int) IChainable
 	DropRight(int) IChainable
+	DropRightWhile(interface{}) IChainable
+	DropWhile(interface{}) IChainable
 	Each(interface{}) IChainableNoReturnValueResult
 	EachRight(interface{}) IChainableNoReturnValueResult
 	Exclude(interface{}) IChainable
@@ -93,39 +202,105 @@ type IChainableOperation interface {
 	ExcludeAt(int) IChainable
 	ExcludeAtMany(...int) IChainable
 	Fill(interface{}, ...int) IChainable
+	FillBackward(interface{}, ...interface{}) IChainable
+	FillForward(interface{}, ...interface{}) IChainable
 	Filter(interface{}) IChainable
 	Find(interface{}, ...int) IChainable
 	FindIndex(interface{}, ...int) IChainable
 	FindLast(interface{}, ...int) IChainable
 	FindLastIndex(interface{}, ...int) IChainable
 	First() IChainable
+	FlatMap(interface{}) IChainable
+	Flatten() IChainable
+	FlattenDeep() IChainable
+	FlattenDepth(int) IChainable
+	Frequencies() IChainable
 	FromPairs() IChainable
-	GroupBy(interface{}) IChainable
+	FullOuterJoin(interface{}, interface{}, interface{}, interface{}) IChainable
+	GroupBy(interface{}) IChainableGroupResult
+	GroupByMany(...interface{}) IChainable
+	GroupByManyOrdered(...interface{}) IChainable
+	GroupByOrdered(interface{}) IChainable
 	Contains(interface{}, ...int) IChainableBoolResult
+	Histogram(int, ...interface{}) IChainable
+	HistogramEdges([]float64, ...interface{}) IChainable
 	IndexOf(interface{}, ...int) IChainableNumberResult
 	Initial() IChainable
+	InnerJoin(interface{}, interface{}, interface{}, interface{}) IChainable
+	Interleave(...interface{}) IChainable
 	Intersection(interface{}) IChainable
 	IntersectionMany(data ...interface{}) IChainable
+	Intersperse(interface{}) IChainable
+	IsSorted(...interface{}) IChainableBoolResult
 	Join(string) IChainableStringResult
-	KeyBy(interface{}) IChainable
+	KeyBy(interface{}, ...KeyByPolicy) IChainable
+	KeyByOrdered(interface{}, ...KeyByPolicy) IChainable
 	Last() IChainable
 	LastIndexOf(interface{}, ...int) IChainableNumberResult
+	LeftJoin(interface{}, interface{}, interface{}, interface{}) IChainable
 	Map(interface{}) IChainable
+	Max(...interface{}) IChainable
+	MaxBy(interface{}) IChainableIndexedResult
+	Mean(...interface{}) IChainableFloatResult
+	Median(...interface{}) IChainableFloatResult
+	MergeSorted(...interface{}) IChainable
+	Min(...interface{}) IChainable
+	MinBy(interface{}) IChainableIndexedResult
+	Mode() IChainable
+	MostFrequent(...interface{}) IChainable
 	Nth(int) IChainable
 	OrderBy(interface{}, ...bool) IChainable
+	Pairwise() IChainable
 	Partition(interface{}) IChainableTwoReturnValueResult
+	Percentile(float64, stats.PercentileMethod, ...interface{}) IChainableFloatResult
+	Permutations(int, ...CombinatoricsLimit) IChainable
+	PowerSet(...CombinatoricsLimit) IChainable
+	Product(...interface{}) IChainable
+	Quantile(float64, stats.PercentileMethod, ...interface{}) IChainableFloatResult
 	Reduce(interface{}, interface{}) IChainable
+	ReduceRight(interface{}, interface{}) IChainable
 	Reject(interface{}) IChainable
+	Resample(time.Duration, interface{}, interface{}) IChainable
 	Reverse() IChainable
+	RightJoin(interface{}, interface{}, interface{}, interface{}) IChainable
+	Rolling(int, interface{}) IChainable
+	RollingBy(time.Duration, interface{}, interface{}) IChainable
+	Rotate(int) IChainable
 	Sample() IChainable
+	SampleCovariance(interface{}, interface{}) IChainableFloatResult
 	SampleSize(int) IChainable
+	SampleStdDev(...interface{}) IChainableFloatResult
+	SampleVariance(...interface{}) IChainableFloatResult
+	Scan(interface{}, interface{}) IChainable
+	SemiJoin(interface{}, interface{}, interface{}) IChainable
 	Shuffle() IChainable
 	Size() IChainable
+	SortedDifference(interface{}, ...interface{}) IChainable
+	SortedIndex(interface{}, ...interface{}) IChainableNumberResult
+	SortedIndexOf(interface{}, ...interface{}) IChainableNumberResult
+	SortedIntersection(interface{}, ...interface{}) IChainable
+	SortedLastIndex(interface{}, ...interface{}) IChainableNumberResult
+	SortedUniq(...interface{}) IChainable
+	SplitInto(int) IChainable
+	SplitWhen(interface{}) IChainable
+	StdDev(...interface{}) IChainableFloatResult
+	Sum(...interface{}) IChainableSumResult
+	SumBig(...interface{}) IChainable
 	Tail() IChainable
 	Take(int) IChainable
 	TakeRight(int) IChainable
+	TakeRightWhile(interface{}) IChainable
+	TakeWhile(interface{}) IChainable
+	TopK(int, interface{}) IChainable
+	Transpose(...ZipPolicy) IChainable
 	Uniq() IChainable
 	UnionMany(...interface{}) IChainable
+	Unzip(...ZipPolicy) IChainable
+	Variance(...interface{}) IChainableFloatResult
+	Window(int, int) IChainable
+	Zip(...interface{}) IChainable
+	ZipWith(interface{}, ...interface{}) IChainable
+	ZScores(...interface{}) IChainable
 }
 
 // Chainable is base type of gubrak chainable operations
@@ -138,8 +313,13 @@ type Chainable struct {
 }
 
 // From is the initial function to use gubrak chainable operation.
-// This function requires one argument, the data that are going to be used in operations
+// This function requires one argument, the data that are going to be used in operations.
+// `*OrderedMap` data is converted into `[]KeyValue`.
 func From(data interface{}) IChainable {
+	if orderedMap, ok := data.(*OrderedMap); ok && orderedMap != nil {
+		data = orderedMap.Pairs()
+	}
+
 	g := new(Chainable)
 	g.data = data
 	g.lastSuccessOperation = OperationNone
@@ -16,6 +16,7 @@ type resultBool struct {
 }
 
 type resultContains = resultBool
+type resultIsSorted = resultBool
 
 func (g *resultBool) ResultAndError() (bool, error) {
 	return g.Result(), g.Error()
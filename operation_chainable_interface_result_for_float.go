@@ -0,0 +1,53 @@
+package gubrak
+
+type IChainableFloatResult interface {
+	ResultAndError() (float64, error)
+	Result() float64
+	Error() error
+	IsError() bool
+	LastSuccessOperation() Operation
+	LastErrorOperation() Operation
+	LastOperation() Operation
+}
+
+type resultFloat struct {
+	chainable *Chainable
+	IChainableFloatResult
+}
+
+type resultMean = resultFloat
+type resultMedian = resultFloat
+type resultPercentile = resultFloat
+type resultVariance = resultFloat
+type resultStdDev = resultFloat
+type resultCovariance = resultFloat
+type resultCorrelation = resultFloat
+
+func (g *resultFloat) ResultAndError() (float64, error) {
+	return g.Result(), g.Error()
+}
+
+func (g *resultFloat) Result() float64 {
+	v, _ := g.chainable.data.(float64)
+	return v
+}
+
+func (g *resultFloat) Error() error {
+	return g.chainable.lastErrorCaught
+}
+
+func (g *resultFloat) IsError() bool {
+	return g.Error() != nil
+}
+
+func (g *resultFloat) LastSuccessOperation() Operation {
+	return g.chainable.lastSuccessOperation
+}
+
+func (g *resultFloat) LastErrorOperation() Operation {
+	return g.chainable.lastErrorOperation
+}
+
+func (g *resultFloat) LastOperation() Operation {
+	return g.chainable.lastOperation
+}
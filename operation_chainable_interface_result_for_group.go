@@ -0,0 +1,604 @@
+package gubrak
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// IChainableGroupResult is the result of `GroupBy()`. Beside the result-related methods, it provides per-group reducers.
+// Each reducer returns map with the same keys as the groups, e.g. `Count()` on `map[string][]Sample` returns `map[string]int`.
+type IChainableGroupResult interface {
+	ResultAndError() (interface{}, error)
+	Result() interface{}
+	Error() error
+	IsError() bool
+	LastSuccessOperation() Operation
+	LastErrorOperation() Operation
+	LastOperation() Operation
+
+	Aggregate(interface{}, interface{}) IChainableGroupResult
+	Avg(...interface{}) IChainableGroupResult
+	Chain() IChainable
+	Count() IChainableGroupResult
+	FilterGroups(interface{}) IChainableGroupResult
+	First() IChainableGroupResult
+	Last() IChainableGroupResult
+	MapGroups(interface{}) IChainableGroupResult
+	Max(...interface{}) IChainableGroupResult
+	Min(...interface{}) IChainableGroupResult
+	Rows() IChainable
+	Sum(...interface{}) IChainableGroupResult
+}
+
+// KeyValue represents single key and value pair, e.g. a row of flattened group result
+type KeyValue struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// GroupNode is a node of `GroupByManyOrdered()` result. `Items` holds all elements of the group, `Children` holds the groups of the next level.
+type GroupNode struct {
+	Key      interface{}
+	Items    interface{}
+	Children []GroupNode
+}
+
+type resultGroup struct {
+	chainable *Chainable
+	IChainableGroupResult
+}
+
+func (g *resultGroup) ResultAndError() (interface{}, error) {
+	return g.Result(), g.Error()
+}
+
+func (g *resultGroup) Result() interface{} {
+	return g.chainable.data
+}
+
+func (g *resultGroup) Error() error {
+	return g.chainable.lastErrorCaught
+}
+
+func (g *resultGroup) IsError() bool {
+	return g.Error() != nil
+}
+
+func (g *resultGroup) LastSuccessOperation() Operation {
+	return g.chainable.lastSuccessOperation
+}
+
+func (g *resultGroup) LastErrorOperation() Operation {
+	return g.chainable.lastErrorOperation
+}
+
+func (g *resultGroup) LastOperation() Operation {
+	return g.chainable.lastOperation
+}
+
+// Aggregate function reduces each group using `Reduce()` operation.
+//
+// Parameters
+//
+// This function require two mandatory parameters:
+//  iteratee interface{} // ==> type: `func(accumulator <any type>, each anyType, i int)<any type>`
+//                       // ==> description: the function invoked per iteration of each group.
+//                       //                  the 3rd argument represents index of each element in the group, and it's optional.
+//  initial interface{}  // ==> description: the initial value of each group.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of reduced value of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of reduced value of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Aggregate(iteratee, initial interface{}) IChainableGroupResult {
+	return g.eachGroup(OperationAggregate, func(err *error, dataValue reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value) {
+		callbackValue, callbackType := inspectFunc(err, iteratee)
+		if *err != nil {
+			return nil, nil
+		}
+
+		validateFuncOutputOneVarDynamic(err, callbackType)
+		if *err != nil {
+			return nil, nil
+		}
+
+		return callbackType.Out(0), func(group, key reflect.Value) reflect.Value {
+			result, errReduce := From(group.Interface()).Reduce(callbackValue.Interface(), initial).ResultAndError()
+			if errReduce != nil {
+				panic(errReduce.Error())
+			}
+
+			if result == nil {
+				return reflect.Zero(callbackType.Out(0))
+			}
+
+			return reflect.ValueOf(result)
+		}
+	})
+}
+
+// Avg function computes the average of each group. The average is `float64`.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of average of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of average of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Avg(args ...interface{}) IChainableGroupResult {
+	return g.eachGroup(OperationAvg, func(err *error, dataValue reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value) {
+		numberOf := elementIteratee(err, "group element", dataValue.Type().Elem().Elem(), args, true)
+		if *err != nil {
+			return nil, nil
+		}
+
+		return reflect.TypeOf(float64(0)), func(group, key reflect.Value) reflect.Value {
+			accumulator := new(numberAccumulator)
+			forEachSlice(group, group.Len(), func(each reflect.Value, i int) {
+				accumulator.add(err, numberOf(each))
+			})
+
+			if accumulator.count == 0 {
+				return reflect.ValueOf(float64(0))
+			}
+
+			return reflect.ValueOf(accumulator.mean())
+		}
+	})
+}
+
+// Chain function returns the chainable of the group map, so other operations can be chained after `GroupBy()` like before, e.g. `GroupBy(fn).Chain().Each(...)`.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with any operation, or with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the group map
+//  .ResultAndError() (interface{}, error) // ==> description: returns the group map, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Chain() IChainable {
+	return g.chainable
+}
+
+// Count function counts the elements of each group.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of number of elements of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of number of elements of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Count() IChainableGroupResult {
+	return g.eachGroup(OperationCount, func(err *error, dataValue reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value) {
+		return reflect.TypeOf(0), func(group, key reflect.Value) reflect.Value {
+			return reflect.ValueOf(group.Len())
+		}
+	})
+}
+
+// FilterGroups function keeps only the groups that the predicate returns truthy for.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  predicate interface{} // ==> type: `func(group []anyType, key anyType) bool`
+//                        // ==> description: the function invoked per group.
+//                        //                  the 2nd argument represents key of each group, and it's optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns the filtered groups
+//  .ResultAndError() (interface{}, error) // ==> description: returns the filtered groups, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) FilterGroups(predicate interface{}) IChainableGroupResult {
+	g.chainable.lastOperation = OperationFilterGroups
+	if g.chainable.IsError() || g.chainable.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		dataValue, keys := _groupInspect(err, g.chainable.data)
+		if *err != nil {
+			return nil
+		}
+
+		callbackValue, callbackType := inspectFunc(err, predicate)
+		if *err != nil {
+			return nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForCollectionLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil
+		}
+
+		validateFuncOutputOneVarBool(err, callbackType, true)
+		if *err != nil {
+			return nil
+		}
+
+		result := reflect.MakeMap(dataValue.Type())
+		forEachCollection(dataValue, keys, func(value, key reflect.Value, i int) {
+			if callFuncCollectionLoop(callbackValue, value, key, callbackTypeNumIn)[0].Bool() {
+				result.SetMapIndex(key, value)
+			}
+		})
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return &resultGroup{chainable: g.chainable.markError(result, err)}
+	}
+
+	return &resultGroup{chainable: g.chainable.markResult(result)}
+}
+
+// First function gets the first element of each group.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of first element of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of first element of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) First() IChainableGroupResult {
+	return g.eachGroup(OperationFirst, func(err *error, dataValue reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value) {
+		return dataValue.Type().Elem().Elem(), func(group, key reflect.Value) reflect.Value {
+			if group.Len() == 0 {
+				return reflect.Value{}
+			}
+
+			return group.Index(0)
+		}
+	})
+}
+
+// Last function gets the last element of each group.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of last element of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of last element of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Last() IChainableGroupResult {
+	return g.eachGroup(OperationLast, func(err *error, dataValue reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value) {
+		return dataValue.Type().Elem().Elem(), func(group, key reflect.Value) reflect.Value {
+			if group.Len() == 0 {
+				return reflect.Value{}
+			}
+
+			return group.Index(group.Len() - 1)
+		}
+	})
+}
+
+// MapGroups function creates map of values by running each group thru iteratee.
+//
+// Parameters
+//
+// This function requires single mandatory parameter:
+//  iteratee interface{} // ==> type: `func(group []anyType, key anyType)<any type>`
+//                       // ==> description: the function invoked per group.
+//                       //                  the 2nd argument represents key of each group, and it's optional.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of mapped value of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of mapped value of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) MapGroups(iteratee interface{}) IChainableGroupResult {
+	return g.eachGroup(OperationMapGroups, func(err *error, dataValue reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value) {
+		callbackValue, callbackType := inspectFunc(err, iteratee)
+		if *err != nil {
+			return nil, nil
+		}
+
+		callbackTypeNumIn := validateFuncInputForCollectionLoop(err, callbackType, dataValue)
+		if *err != nil {
+			return nil, nil
+		}
+
+		validateFuncOutputOneVarDynamic(err, callbackType)
+		if *err != nil {
+			return nil, nil
+		}
+
+		return callbackType.Out(0), func(group, key reflect.Value) reflect.Value {
+			return callFuncCollectionLoop(callbackValue, group, key, callbackTypeNumIn)[0]
+		}
+	})
+}
+
+// Max function gets the maximum element of each group. If iteratee is provided, the element with maximum iteratee result is picked.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<any type>`
+//                       // ==> description: the function to get the value to compare of each element.
+//                       //                  if not provided, the element itself is compared.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of maximum element of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of maximum element of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Max(args ...interface{}) IChainableGroupResult {
+	return g.extremeOfGroup(OperationMax, 1, args)
+}
+
+// Min function gets the minimum element of each group. If iteratee is provided, the element with minimum iteratee result is picked.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<any type>`
+//                       // ==> description: the function to get the value to compare of each element.
+//                       //                  if not provided, the element itself is compared.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of minimum element of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of minimum element of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Min(args ...interface{}) IChainableGroupResult {
+	return g.extremeOfGroup(OperationMin, -1, args)
+}
+
+// Rows function flattens the map into slice of `KeyValue`, sorted by the key.
+//
+// Parameters
+//
+// This function does not requires any parameter.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns `[]KeyValue`
+//  .ResultAndError() (interface{}, error) // ==> description: returns `[]KeyValue`, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Rows() IChainable {
+	g.chainable.lastOperation = OperationRows
+	if g.chainable.IsError() || g.chainable.shouldReturn() {
+		return g.chainable
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		if !isNonNilData(err, "data", g.chainable.data) {
+			return nil
+		}
+
+		dataValue, _, dataValueKind, _ := inspectData(g.chainable.data)
+		if dataValueKind != reflect.Map {
+			*err = errors.New("data must be map")
+			return nil
+		}
+
+		keys := _sortedMapKeys(dataValue)
+		result := make([]KeyValue, 0, len(keys))
+		forEachCollection(dataValue, keys, func(value, key reflect.Value, i int) {
+			result = append(result, KeyValue{Key: key.Interface(), Value: value.Interface()})
+		})
+
+		return result
+	}(&err)
+	if err != nil {
+		return g.chainable.markError(result, err)
+	}
+
+	return g.chainable.markResult(result)
+}
+
+// Sum function computes the sum of each group. The data type of the sum is the same as the number data type, error is returned if the sum overflows it.
+//
+// Parameters
+//
+// This function requires single optional parameter:
+//  iteratee interface{} // ==> type: `func(each anyType)<number type>`
+//                       // ==> description: the function to get the number of each element.
+//                       //                  if not provided, the element itself is used.
+//
+// Return values
+//
+// Chain with these methods to get result:
+//  .Result() interface{}                  // ==> description: returns map of sum of each group
+//  .ResultAndError() (interface{}, error) // ==> description: returns map of sum of each group, and error object
+//  .Error() error                         // ==> description: returns error object
+//  .IsError() bool                        // ==> description: return `true` on error, otherwise `false`
+func (g *resultGroup) Sum(args ...interface{}) IChainableGroupResult {
+	return g.eachGroup(OperationSum, func(err *error, dataValue reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value) {
+		numberOf := elementIteratee(err, "group element", dataValue.Type().Elem().Elem(), args, true)
+		if *err != nil {
+			return nil, nil
+		}
+
+		numberType := dataValue.Type().Elem().Elem()
+		if len(args) > 0 && args[0] != nil {
+			numberType = reflect.TypeOf(args[0]).Out(0)
+		}
+
+		return numberType, func(group, key reflect.Value) reflect.Value {
+			accumulator := new(numberAccumulator)
+			forEachSlice(group, group.Len(), func(each reflect.Value, i int) {
+				accumulator.add(err, numberOf(each))
+			})
+
+			sum := reflect.New(numberType).Elem()
+			integer := accumulator.integer()
+
+			switch numberType.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				if !integer.IsInt64() || sum.OverflowInt(integer.Int64()) {
+					panic(fmt.Errorf("sum of group %v overflows %s", key.Interface(), numberType))
+				}
+
+				sum.SetInt(integer.Int64())
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				if !integer.IsUint64() || sum.OverflowUint(integer.Uint64()) {
+					panic(fmt.Errorf("sum of group %v overflows %s", key.Interface(), numberType))
+				}
+
+				sum.SetUint(integer.Uint64())
+			default:
+				sum.SetFloat(accumulator.float())
+			}
+
+			return sum
+		}
+	})
+}
+
+// eachGroup runs `reducer` on each group, then stores the result with the same key
+func (g *resultGroup) eachGroup(operation Operation, prepare func(*error, reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value)) IChainableGroupResult {
+	g.chainable.lastOperation = operation
+	if g.chainable.IsError() || g.chainable.shouldReturn() {
+		return g
+	}
+
+	err := (error)(nil)
+	result := func(err *error) interface{} {
+		defer catch(err)
+
+		dataValue, keys := _groupInspect(err, g.chainable.data)
+		if *err != nil {
+			return nil
+		}
+
+		resultType, reducer := prepare(err, dataValue)
+		if *err != nil {
+			return nil
+		}
+
+		result := reflect.MakeMap(reflect.MapOf(dataValue.Type().Key(), resultType))
+		forEachCollection(dataValue, keys, func(value, key reflect.Value, i int) {
+			if res := reducer(value, key); res.IsValid() {
+				result.SetMapIndex(key, res)
+			}
+		})
+
+		return result.Interface()
+	}(&err)
+	if err != nil {
+		return &resultGroup{chainable: g.chainable.markError(result, err)}
+	}
+
+	return &resultGroup{chainable: g.chainable.markResult(result)}
+}
+
+func (g *resultGroup) extremeOfGroup(operation Operation, direction int, args []interface{}) IChainableGroupResult {
+	return g.eachGroup(operation, func(err *error, dataValue reflect.Value) (reflect.Type, func(reflect.Value, reflect.Value) reflect.Value) {
+		valueOf := elementIteratee(err, "group element", dataValue.Type().Elem().Elem(), args, false)
+		if *err != nil {
+			return nil, nil
+		}
+
+		return dataValue.Type().Elem().Elem(), func(group, key reflect.Value) reflect.Value {
+			var picked, pickedValue reflect.Value
+
+			forEachSlice(group, group.Len(), func(each reflect.Value, i int) {
+				value := valueOf(each)
+				if isNaNValue(pickedValue) {
+					return
+				}
+
+				if !picked.IsValid() || isNaNValue(value) {
+					picked, pickedValue = each, value
+					return
+				}
+
+				res, ok := compareValues(value, pickedValue)
+				if !ok {
+					panic(fmt.Sprintf("cannot compare %v with %v", value.Interface(), pickedValue.Interface()))
+				}
+
+				if res*direction > 0 {
+					picked, pickedValue = each, value
+				}
+			})
+
+			return picked
+		}
+	})
+}
+
+func _groupInspect(err *error, data interface{}) (reflect.Value, []reflect.Value) {
+	if !isNonNilData(err, "data", data) {
+		return reflect.Value{}, nil
+	}
+
+	dataValue, dataValueType, dataValueKind, _ := inspectData(data)
+	if dataValueKind != reflect.Map {
+		*err = errors.New("data must be map of slice")
+		return dataValue, nil
+	}
+
+	if elemKind := dataValueType.Elem().Kind(); elemKind != reflect.Slice && elemKind != reflect.Array {
+		*err = errors.New("data must be map of slice")
+		return dataValue, nil
+	}
+
+	return dataValue, dataValue.MapKeys()
+}
+
+// _sortedMapKeys returns the keys of map, sorted ascending. Incomparable keys are sorted by their string representation.
+func _sortedMapKeys(dataValue reflect.Value) []reflect.Value {
+	keys := dataValue.MapKeys()
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		if res, ok := compareValues(keys[i], keys[j]); ok {
+			return res < 0
+		}
+
+		return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+	})
+
+	return keys
+}
@@ -0,0 +1,66 @@
+package gubrak
+
+type IChainableIndexedResult interface {
+	ResultAndError() (interface{}, int, error)
+	Result() interface{}
+	Index() int
+	Error() error
+	IsError() bool
+	LastSuccessOperation() Operation
+	LastErrorOperation() Operation
+	LastOperation() Operation
+}
+
+// indexedValue is the data stored in chainable for indexed result
+type indexedValue struct {
+	value interface{}
+	index int
+}
+
+type resultIndexed struct {
+	chainable *Chainable
+	IChainableIndexedResult
+}
+
+type resultMinBy = resultIndexed
+type resultMaxBy = resultIndexed
+
+func (g *resultIndexed) ResultAndError() (interface{}, int, error) {
+	return g.Result(), g.Index(), g.Error()
+}
+
+func (g *resultIndexed) Result() interface{} {
+	if v, ok := g.chainable.data.(indexedValue); ok {
+		return v.value
+	}
+
+	return nil
+}
+
+func (g *resultIndexed) Index() int {
+	if v, ok := g.chainable.data.(indexedValue); ok {
+		return v.index
+	}
+
+	return -1
+}
+
+func (g *resultIndexed) Error() error {
+	return g.chainable.lastErrorCaught
+}
+
+func (g *resultIndexed) IsError() bool {
+	return g.Error() != nil
+}
+
+func (g *resultIndexed) LastSuccessOperation() Operation {
+	return g.chainable.lastSuccessOperation
+}
+
+func (g *resultIndexed) LastErrorOperation() Operation {
+	return g.chainable.lastErrorOperation
+}
+
+func (g *resultIndexed) LastOperation() Operation {
+	return g.chainable.lastOperation
+}
@@ -0,0 +1,66 @@
+package gubrak
+
+type IChainableMultipleReturnValueResult interface {
+	ResultAndError() ([]interface{}, error)
+	Result() []interface{}
+	ResultAt(int) interface{}
+	ResultRemainder() interface{}
+	Len() int
+	Error() error
+	IsError() bool
+	LastSuccessOperation() Operation
+	LastErrorOperation() Operation
+	LastOperation() Operation
+}
+
+type resultMultipleReturnValue struct {
+	chainable *Chainable
+	IChainableMultipleReturnValueResult
+}
+
+type resultClassify = resultMultipleReturnValue
+
+func (g *resultMultipleReturnValue) ResultAndError() ([]interface{}, error) {
+	return g.Result(), g.Error()
+}
+
+func (g *resultMultipleReturnValue) Result() []interface{} {
+	v, _ := g.chainable.data.([]interface{})
+	return v
+}
+
+func (g *resultMultipleReturnValue) ResultAt(i int) interface{} {
+	if v := g.Result(); i >= 0 && i < len(v) {
+		return v[i]
+	}
+
+	return nil
+}
+
+func (g *resultMultipleReturnValue) ResultRemainder() interface{} {
+	return g.ResultAt(g.Len() - 1)
+}
+
+func (g *resultMultipleReturnValue) Len() int {
+	return len(g.Result())
+}
+
+func (g *resultMultipleReturnValue) Error() error {
+	return g.chainable.lastErrorCaught
+}
+
+func (g *resultMultipleReturnValue) IsError() bool {
+	return g.Error() != nil
+}
+
+func (g *resultMultipleReturnValue) LastSuccessOperation() Operation {
+	return g.chainable.lastSuccessOperation
+}
+
+func (g *resultMultipleReturnValue) LastErrorOperation() Operation {
+	return g.chainable.lastErrorOperation
+}
+
+func (g *resultMultipleReturnValue) LastOperation() Operation {
+	return g.chainable.lastOperation
+}
@@ -18,6 +18,9 @@ type resultNumber struct {
 type resultCount = resultNumber
 type resultLastIndexOf = resultNumber
 type resultIndexOf = resultNumber
+type resultSortedIndex = resultNumber
+type resultSortedIndexOf = resultNumber
+type resultSortedLastIndex = resultNumber
 
 func (g *resultNumber) ResultAndError() (int, error) {
 	return g.Result(), g.Error()
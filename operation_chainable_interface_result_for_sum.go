@@ -0,0 +1,104 @@
+package gubrak
+
+import (
+	"math"
+	"math/big"
+)
+
+// IChainableSumResult is the result of `Sum()`. Use `Int()`, `Big()` or `Float()` to get the sum in the desired type, regardless of the number data type.
+type IChainableSumResult interface {
+	ResultAndError() (interface{}, error)
+	Result() interface{}
+	Int() (int64, bool)
+	Big() *big.Int
+	Float() float64
+	IsFloat() bool
+	Error() error
+	IsError() bool
+	LastSuccessOperation() Operation
+	LastErrorOperation() Operation
+	LastOperation() Operation
+}
+
+type resultSum struct {
+	chainable *Chainable
+	IChainableSumResult
+}
+
+func (g *resultSum) ResultAndError() (interface{}, error) {
+	return g.Result(), g.Error()
+}
+
+// Result returns `*big.Int` for integer kinds, or `float64` for float kinds
+func (g *resultSum) Result() interface{} {
+	return g.chainable.data
+}
+
+// Int returns the sum as `int64`. The 2nd return value is `false` if the sum is not an integer which fits in `int64`
+func (g *resultSum) Int() (int64, bool) {
+	switch v := g.chainable.data.(type) {
+	case *big.Int:
+		return v.Int64(), v.IsInt64()
+	case float64:
+		isInt64 := v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64
+		return int64(v), isInt64
+	}
+
+	return 0, false
+}
+
+// Big returns the sum as `*big.Int`, float sum is truncated toward zero. It returns `nil` on error, or if the float sum is NaN or infinity
+func (g *resultSum) Big() *big.Int {
+	switch v := g.chainable.data.(type) {
+	case *big.Int:
+		return new(big.Int).Set(v)
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil
+		}
+
+		res, _ := big.NewFloat(v).Int(nil)
+		return res
+	}
+
+	return nil
+}
+
+// Float returns the sum as `float64`, integer sum is rounded to the nearest `float64`
+func (g *resultSum) Float() float64 {
+	switch v := g.chainable.data.(type) {
+	case *big.Int:
+		res, _ := new(big.Float).SetInt(v).Float64()
+		return res
+	case float64:
+		return v
+	}
+
+	return 0
+}
+
+// IsFloat returns `true` if the numbers are float kinds
+func (g *resultSum) IsFloat() bool {
+	_, ok := g.chainable.data.(float64)
+	return ok
+}
+
+func (g *resultSum) Error() error {
+	return g.chainable.lastErrorCaught
+}
+
+func (g *resultSum) IsError() bool {
+	return g.Error() != nil
+}
+
+func (g *resultSum) LastSuccessOperation() Operation {
+	return g.chainable.lastSuccessOperation
+}
+
+func (g *resultSum) LastErrorOperation() Operation {
+	return g.chainable.lastErrorOperation
+}
+
+func (g *resultSum) LastOperation() Operation {
+	return g.chainable.lastOperation
+}
@@ -0,0 +1,401 @@
+package gubrak
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// CombinatoricsLimit is the maximum number of tuples generated by `Product()`, `Permutations()`, `Combinations()`,
+// `CombinationsWithReplacement()` and `PowerSet()`. Pass it as the optional last argument of the operation, e.g. `Permutations(3, 100)`.
+// When not provided, `DefaultCombinatoricsLimit` is used. Zero or negative number disables the limit.
+// The iterator forms, e.g. `ProductIterator()`, are not limited since they generate the tuples one by one.
+type CombinatoricsLimit int
+
+// DefaultCombinatoricsLimit is the limit used when the operation is called without `CombinatoricsLimit`
+const DefaultCombinatoricsLimit CombinatoricsLimit = 1000000
+
+// CombinatoricsLimitError is the error returned when the number of tuples would exceed the `CombinatoricsLimit`
+type CombinatoricsLimitError struct {
+	Size  *big.Int
+	Limit int
+}
+
+func (e *CombinatoricsLimitError) Error() string {
+	return fmt.Sprintf("output size %s exceeds the limit %d", e.Size, e.Limit)
+}
+
+// CombinatoricsIterator generates the tuples of combinatorics operation one by one, in the same order as the operation result.
+// Call `Next()` to advance to the next tuple, then `Value()` to get it.
+//  for it.Next() {
+//      tuple := it.Value().([]string)
+//  }
+type CombinatoricsIterator struct {
+	sources   []reflect.Value
+	tupleType reflect.Type
+	first     []int
+	advance   func(indices []int) []int
+	indices   []int
+	isStarted bool
+}
+
+// Next advances the iterator to the next tuple. It returns `false` when there is no more tuple.
+func (it *CombinatoricsIterator) Next() bool {
+	if !it.isStarted {
+		it.isStarted = true
+		it.indices = it.first
+	} else if it.indices != nil {
+		it.indices = it.advance(it.indices)
+	}
+
+	return it.indices != nil
+}
+
+// Value gets the current tuple, as new slice. The tuple is `[]T` when all sources have the same element type, otherwise `[]interface{}`.
+func (it *CombinatoricsIterator) Value() interface{} {
+	if it.indices == nil {
+		return nil
+	}
+
+	tuple := makeSlice(it.tupleType, len(it.indices), len(it.indices))
+	for p, index := range it.indices {
+		source := it.sources[0]
+		if len(it.sources) > 1 {
+			source = it.sources[p]
+		}
+
+		tuple.Index(p).Set(source.Index(index))
+	}
+
+	return tuple.Interface()
+}
+
+// ProductIterator function creates iterator of the cartesian product of `data` and the other slices
+func ProductIterator(data interface{}, others ...interface{}) (*CombinatoricsIterator, error) {
+	err := (error)(nil)
+	it, _ := _productIterator(&err, data, others)
+	return it, err
+}
+
+// PermutationsIterator function creates iterator of the `k`-length permutations of `data`
+func PermutationsIterator(data interface{}, k int) (*CombinatoricsIterator, error) {
+	err := (error)(nil)
+	it, _ := _permutationsIterator(&err, data, k)
+	return it, err
+}
+
+// CombinationsIterator function creates iterator of the `k`-length combinations of `data`
+func CombinationsIterator(data interface{}, k int) (*CombinatoricsIterator, error) {
+	err := (error)(nil)
+	it, _ := _combinationsIterator(&err, data, k)
+	return it, err
+}
+
+// CombinationsWithReplacementIterator function creates iterator of the `k`-length combinations of `data`, allowing each element to be repeated
+func CombinationsWithReplacementIterator(data interface{}, k int) (*CombinatoricsIterator, error) {
+	err := (error)(nil)
+	it, _ := _combinationsWithReplacementIterator(&err, data, k)
+	return it, err
+}
+
+// PowerSetIterator function creates iterator of all subsets of `data`, from the smallest
+func PowerSetIterator(data interface{}) (*CombinatoricsIterator, error) {
+	err := (error)(nil)
+	it, _ := _powerSetIterator(&err, data)
+	return it, err
+}
+
+// _combinatoricsSource inspects `data` of combinatorics operation
+func _combinatoricsSource(err *error, label string, data interface{}) (reflect.Value, int) {
+	if !isNonNilData(err, label, data) {
+		return reflect.Value{}, 0
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, label, dataValue) {
+		return reflect.Value{}, 0
+	}
+
+	return dataValue, dataValueLen
+}
+
+func _combinatoricsLength(err *error, k int) bool {
+	if k < 0 {
+		*err = errors.New("k should not be negative number")
+		return false
+	}
+
+	return true
+}
+
+// _combinatoricsLimit gets the limit from optional `limits`, or the default limit if not provided
+func _combinatoricsLimit(limits []CombinatoricsLimit) CombinatoricsLimit {
+	if len(limits) > 0 {
+		return limits[0]
+	}
+
+	return DefaultCombinatoricsLimit
+}
+
+// _productArgs separates the slices to multiply from the optional `CombinatoricsLimit`
+func _productArgs(args []interface{}) ([]interface{}, CombinatoricsLimit) {
+	others, limit := make([]interface{}, 0, len(args)), DefaultCombinatoricsLimit
+	for _, each := range args {
+		if value, ok := each.(CombinatoricsLimit); ok {
+			limit = value
+			continue
+		}
+
+		others = append(others, each)
+	}
+
+	return others, limit
+}
+
+// _combinatoricsCollect generates all tuples of the iterator, after making sure the count does not exceed `limit`
+func _combinatoricsCollect(err *error, it *CombinatoricsIterator, count *big.Int, limit CombinatoricsLimit) interface{} {
+	capacity := 0
+	if limit > 0 {
+		if count.Cmp(big.NewInt(int64(limit))) > 0 {
+			*err = &CombinatoricsLimitError{Size: count, Limit: int(limit)}
+			return nil
+		}
+
+		capacity = int(count.Int64())
+	}
+
+	result := makeSlice(reflect.SliceOf(it.tupleType), 0, capacity)
+	for it.Next() {
+		result = reflect.Append(result, reflect.ValueOf(it.Value()))
+	}
+
+	return result.Interface()
+}
+
+// _sequence creates `[]int{0, 1, ..., n-1}`
+func _sequence(n int) []int {
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	return indices
+}
+
+func _productIterator(err *error, data interface{}, others []interface{}) (*CombinatoricsIterator, *big.Int) {
+	dataValue, dataValueLen := _combinatoricsSource(err, "data", data)
+	if *err != nil {
+		return nil, nil
+	}
+
+	sources := []reflect.Value{dataValue}
+	lengths := []int{dataValueLen}
+	for i, eachData := range others {
+		eachValue, eachValueLen := _combinatoricsSource(err, fmt.Sprintf("product data %d", i+1), eachData)
+		if *err != nil {
+			return nil, nil
+		}
+
+		sources = append(sources, eachValue)
+		lengths = append(lengths, eachValueLen)
+	}
+
+	tupleType := reflect.SliceOf(dataValue.Type().Elem())
+	for _, source := range sources[1:] {
+		if source.Type().Elem() != tupleType.Elem() {
+			tupleType = reflect.TypeOf([]interface{}{})
+			break
+		}
+	}
+
+	count := big.NewInt(1)
+	for _, length := range lengths {
+		count.Mul(count, big.NewInt(int64(length)))
+	}
+
+	it := &CombinatoricsIterator{sources: sources, tupleType: tupleType}
+	if count.Sign() > 0 {
+		it.first = make([]int, len(sources))
+	}
+
+	// the rightmost index advances the fastest
+	it.advance = func(indices []int) []int {
+		for p := len(indices) - 1; p >= 0; p-- {
+			indices[p]++
+			if indices[p] < lengths[p] {
+				return indices
+			}
+
+			indices[p] = 0
+		}
+
+		return nil
+	}
+
+	return it, count
+}
+
+func _permutationsIterator(err *error, data interface{}, k int) (*CombinatoricsIterator, *big.Int) {
+	dataValue, n := _combinatoricsSource(err, "data", data)
+	if *err != nil || !_combinatoricsLength(err, k) {
+		return nil, nil
+	}
+
+	count := big.NewInt(0)
+	if k <= n {
+		count.MulRange(int64(n-k+1), int64(n))
+	}
+
+	it := &CombinatoricsIterator{sources: []reflect.Value{dataValue}, tupleType: reflect.SliceOf(dataValue.Type().Elem())}
+	if k <= n {
+		it.first = _sequence(k)
+	}
+
+	// find the rightmost position which can be increased to an unused index, then fill the rest with the smallest unused indices
+	it.advance = func(indices []int) []int {
+		isUsed := make([]bool, n)
+		for _, index := range indices {
+			isUsed[index] = true
+		}
+
+		for p := len(indices) - 1; p >= 0; p-- {
+			isUsed[indices[p]] = false
+
+			for next := indices[p] + 1; next < n; next++ {
+				if isUsed[next] {
+					continue
+				}
+
+				indices[p] = next
+				isUsed[next] = true
+
+				q := p + 1
+				for unused := 0; q < len(indices); unused++ {
+					if !isUsed[unused] {
+						indices[q] = unused
+						isUsed[unused] = true
+						q++
+					}
+				}
+
+				return indices
+			}
+		}
+
+		return nil
+	}
+
+	return it, count
+}
+
+func _combinationsIterator(err *error, data interface{}, k int) (*CombinatoricsIterator, *big.Int) {
+	dataValue, n := _combinatoricsSource(err, "data", data)
+	if *err != nil || !_combinatoricsLength(err, k) {
+		return nil, nil
+	}
+
+	count := big.NewInt(0)
+	if k <= n {
+		count.Binomial(int64(n), int64(k))
+	}
+
+	it := &CombinatoricsIterator{sources: []reflect.Value{dataValue}, tupleType: reflect.SliceOf(dataValue.Type().Elem())}
+	if k <= n {
+		it.first = _sequence(k)
+	}
+
+	it.advance = func(indices []int) []int {
+		return _nextCombination(indices, n)
+	}
+
+	return it, count
+}
+
+func _combinationsWithReplacementIterator(err *error, data interface{}, k int) (*CombinatoricsIterator, *big.Int) {
+	dataValue, n := _combinatoricsSource(err, "data", data)
+	if *err != nil || !_combinatoricsLength(err, k) {
+		return nil, nil
+	}
+
+	count := big.NewInt(0)
+	if n > 0 {
+		count.Binomial(int64(n+k-1), int64(k))
+	} else if k == 0 {
+		count.SetInt64(1)
+	}
+
+	it := &CombinatoricsIterator{sources: []reflect.Value{dataValue}, tupleType: reflect.SliceOf(dataValue.Type().Elem())}
+	if count.Sign() > 0 {
+		it.first = make([]int, k)
+	}
+
+	it.advance = func(indices []int) []int {
+		p := len(indices) - 1
+		for p >= 0 && indices[p] == n-1 {
+			p--
+		}
+
+		if p < 0 {
+			return nil
+		}
+
+		next := indices[p] + 1
+		for q := p; q < len(indices); q++ {
+			indices[q] = next
+		}
+
+		return indices
+	}
+
+	return it, count
+}
+
+func _powerSetIterator(err *error, data interface{}) (*CombinatoricsIterator, *big.Int) {
+	dataValue, n := _combinatoricsSource(err, "data", data)
+	if *err != nil {
+		return nil, nil
+	}
+
+	count := new(big.Int).Lsh(big.NewInt(1), uint(n))
+
+	it := &CombinatoricsIterator{sources: []reflect.Value{dataValue}, tupleType: reflect.SliceOf(dataValue.Type().Elem())}
+	it.first = []int{}
+
+	// subsets of the same size are generated in the order of combinations, then continue with the next size
+	it.advance = func(indices []int) []int {
+		if next := _nextCombination(indices, n); next != nil {
+			return next
+		}
+
+		if len(indices) == n {
+			return nil
+		}
+
+		return _sequence(len(indices) + 1)
+	}
+
+	return it, count
+}
+
+// _nextCombination advances the indices of `k`-length combination of `n` elements in lexicographic order, or returns nil after the last one
+func _nextCombination(indices []int, n int) []int {
+	k := len(indices)
+
+	p := k - 1
+	for p >= 0 && indices[p] == p+n-k {
+		p--
+	}
+
+	if p < 0 {
+		return nil
+	}
+
+	indices[p]++
+	for q := p + 1; q < k; q++ {
+		indices[q] = indices[q-1] + 1
+	}
+
+	return indices
+}
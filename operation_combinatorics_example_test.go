@@ -0,0 +1,48 @@
+package gubrak
+
+import (
+	"fmt"
+)
+
+func ExampleChainable_Product_product1() {
+	result := From([]string{"linux", "darwin"}).
+		Product([]string{"amd64", "arm64"}).
+		Result()
+
+	fmt.Println(result)
+	// ===> [][]string{ {"linux", "amd64"}, {"linux", "arm64"}, {"darwin", "amd64"}, {"darwin", "arm64"} }
+}
+
+func ExampleChainable_Combinations_combinations1() {
+	result := From([]string{"damian", "grayson", "tim"}).
+		Combinations(2).
+		Result()
+
+	fmt.Println(result)
+	// ===> [][]string{ {"damian", "grayson"}, {"damian", "tim"}, {"grayson", "tim"} }
+}
+
+func ExampleChainable_PowerSet_powerSet1() {
+	result := From([]int{1, 2}).
+		PowerSet().
+		Result()
+
+	fmt.Println(result)
+	// ===> [][]int{ {}, {1}, {2}, {1, 2} }
+}
+
+func ExamplePermutationsIterator() {
+	it, err := PermutationsIterator([]string{"a", "b", "c"}, 2)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	for it.Next() {
+		fmt.Println(it.Value())
+	}
+	// ===> []string{ "a", "b" }
+	// ===> []string{ "a", "c" }
+	// ===> []string{ "b", "a" }
+	// ===> ...
+}
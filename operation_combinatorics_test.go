@@ -0,0 +1,256 @@
+package gubrak
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProduct(t *testing.T) {
+	result, err := From([]string{"linux", "darwin"}).
+		Product([]string{"amd64", "arm64"}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{
+		{"linux", "amd64"},
+		{"linux", "arm64"},
+		{"darwin", "amd64"},
+		{"darwin", "arm64"},
+	}, result)
+}
+
+func TestProductDifferentElementType(t *testing.T) {
+	result, err := From([]string{"a", "b"}).
+		Product([]int{1}, []bool{true, false}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]interface{}{
+		{"a", 1, true},
+		{"a", 1, false},
+		{"b", 1, true},
+		{"b", 1, false},
+	}, result)
+}
+
+func TestProductWithoutOthers(t *testing.T) {
+	result, err := From([]int{1, 2}).Product().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1}, {2}}, result)
+}
+
+func TestProductEmptySlice(t *testing.T) {
+	result, err := From([]int{1, 2}).Product([]int{}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{}, result)
+}
+
+func TestProductInvalidData(t *testing.T) {
+	_, err := From([]int{1, 2}).Product(3).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "product data 1 must be slice")
+}
+
+func TestPermutations(t *testing.T) {
+	result, err := From([]string{"a", "b", "c"}).Permutations(2).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{
+		{"a", "b"}, {"a", "c"},
+		{"b", "a"}, {"b", "c"},
+		{"c", "a"}, {"c", "b"},
+	}, result)
+}
+
+func TestPermutationsFull(t *testing.T) {
+	result, err := From([]int{1, 2, 3}).Permutations(3).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{
+		{1, 2, 3}, {1, 3, 2},
+		{2, 1, 3}, {2, 3, 1},
+		{3, 1, 2}, {3, 2, 1},
+	}, result)
+}
+
+func TestPermutationsEdgeCases(t *testing.T) {
+	result, err := From([]int{1, 2}).Permutations(0).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{}}, result)
+
+	result, err = From([]int{1, 2}).Permutations(3).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{}, result)
+
+	_, err = From([]int{1, 2}).Permutations(-1).ResultAndError()
+	assert.EqualError(t, err, "k should not be negative number")
+}
+
+func TestCombinations(t *testing.T) {
+	result, err := From([]int{1, 2, 3, 4}).Combinations(2).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{
+		{1, 2}, {1, 3}, {1, 4},
+		{2, 3}, {2, 4},
+		{3, 4},
+	}, result)
+}
+
+func TestCombinationsEdgeCases(t *testing.T) {
+	result, err := From([]int{}).Combinations(0).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{}}, result)
+
+	result, err = From([]int{1, 2}).Combinations(3).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{}, result)
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	result, err := From([]string{"a", "b", "c"}).CombinationsWithReplacement(2).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{
+		{"a", "a"}, {"a", "b"}, {"a", "c"},
+		{"b", "b"}, {"b", "c"},
+		{"c", "c"},
+	}, result)
+}
+
+func TestCombinationsWithReplacementEmptyData(t *testing.T) {
+	result, err := From([]string{}).CombinationsWithReplacement(2).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]string{}, result)
+}
+
+func TestPowerSet(t *testing.T) {
+	result, err := From([]int{1, 2, 3}).PowerSet().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{
+		{},
+		{1}, {2}, {3},
+		{1, 2}, {1, 3}, {2, 3},
+		{1, 2, 3},
+	}, result)
+}
+
+func TestPowerSetEmptyData(t *testing.T) {
+	result, err := From([]int{}).PowerSet().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{}}, result)
+}
+
+func TestCombinatoricsLimit(t *testing.T) {
+	_, err := From(make([]int, 10)).Permutations(3, 100).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "output size 720 exceeds the limit 100")
+
+	limitErr := new(CombinatoricsLimitError)
+	assert.True(t, errors.As(err, &limitErr))
+	assert.Equal(t, int64(720), limitErr.Size.Int64())
+
+	_, err = From(make([]int, 200)).PowerSet(100).ResultAndError()
+	assert.EqualError(t, err, "output size 1606938044258990275541962092341162602522202993782792835301376 exceeds the limit 100")
+
+	_, err = From(make([]int, 5)).Combinations(2, 9).ResultAndError()
+	assert.EqualError(t, err, "output size 10 exceeds the limit 9")
+
+	_, err = From(make([]int, 3)).CombinationsWithReplacement(2, 5).ResultAndError()
+	assert.EqualError(t, err, "output size 6 exceeds the limit 5")
+
+	result, err := From(make([]int, 10)).Permutations(3, 0).ResultAndError()
+	assert.Nil(t, err)
+	assert.Len(t, result, 720)
+}
+
+func TestCombinatoricsDefaultLimit(t *testing.T) {
+	_, err := From(make([]int, 21)).PowerSet().ResultAndError()
+	assert.EqualError(t, err, "output size 2097152 exceeds the limit 1000000")
+
+	result, err := From(make([]int, 10)).Permutations(3).ResultAndError()
+	assert.Nil(t, err)
+	assert.Len(t, result, 720)
+}
+
+func TestProductLimit(t *testing.T) {
+	_, err := From([]int{1, 2, 3}).Product([]int{4, 5}, CombinatoricsLimit(5)).ResultAndError()
+	assert.EqualError(t, err, "output size 6 exceeds the limit 5")
+
+	result, err := From([]int{1, 2}).Product([]int{3}, CombinatoricsLimit(2)).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, [][]int{{1, 3}, {2, 3}}, result)
+}
+
+func TestCombinatoricsIterator(t *testing.T) {
+	it, err := CombinationsIterator([]string{"a", "b", "c"}, 2)
+	assert.Nil(t, err)
+
+	result := make([][]string, 0)
+	for it.Next() {
+		result = append(result, it.Value().([]string))
+	}
+
+	assert.EqualValues(t, [][]string{{"a", "b"}, {"a", "c"}, {"b", "c"}}, result)
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Value())
+}
+
+func TestCombinatoricsIteratorIsNotLimited(t *testing.T) {
+	it, err := PowerSetIterator(make([]int, 100))
+	assert.Nil(t, err)
+
+	count := 0
+	for count < 1000 && it.Next() {
+		count++
+	}
+
+	assert.Equal(t, 1000, count)
+	assert.Len(t, it.Value(), 2)
+}
+
+func TestCombinatoricsIteratorMatchesOperation(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+	iterators := []func() (*CombinatoricsIterator, error){
+		func() (*CombinatoricsIterator, error) { return ProductIterator(data, []int{5, 6}) },
+		func() (*CombinatoricsIterator, error) { return PermutationsIterator(data, 3) },
+		func() (*CombinatoricsIterator, error) { return CombinationsIterator(data, 3) },
+		func() (*CombinatoricsIterator, error) { return CombinationsWithReplacementIterator(data, 3) },
+		func() (*CombinatoricsIterator, error) { return PowerSetIterator(data) },
+	}
+	operations := []IChainable{
+		From(data).Product([]int{5, 6}),
+		From(data).Permutations(3),
+		From(data).Combinations(3),
+		From(data).CombinationsWithReplacement(3),
+		From(data).PowerSet(),
+	}
+
+	for i, newIterator := range iterators {
+		it, err := newIterator()
+		assert.Nil(t, err)
+
+		result := make([][]int, 0)
+		for it.Next() {
+			result = append(result, it.Value().([]int))
+		}
+
+		assert.EqualValues(t, operations[i].Result(), result)
+	}
+}
+
+func TestCombinatoricsIteratorInvalidData(t *testing.T) {
+	_, err := PermutationsIterator("abc", 2)
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "data must be slice")
+}
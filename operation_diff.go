@@ -0,0 +1,290 @@
+package gubrak
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// DiffOperation is the operation of each step of the edit script returned by `Diff()`
+type DiffOperation int
+
+const (
+	// DiffEqual keeps the element, it's not changed
+	DiffEqual DiffOperation = iota
+
+	// DiffModify keeps the element position, but its value is changed
+	DiffModify
+
+	// DiffInsert inserts the element of the other slice
+	DiffInsert
+
+	// DiffDelete deletes the element of `data`
+	DiffDelete
+)
+
+func (o DiffOperation) String() string {
+	switch o {
+	case DiffEqual:
+		return "equal"
+	case DiffModify:
+		return "modify"
+	case DiffInsert:
+		return "insert"
+	case DiffDelete:
+		return "delete"
+	}
+
+	return fmt.Sprintf("DiffOperation(%d)", int(o))
+}
+
+// DiffChange is a changed element, matched by its key. `OldIndex` and `OldValue` come from `data`, meanwhile `NewIndex` and `NewValue` come from the other slice.
+// `OldIndex` is -1 for added element, and `NewIndex` is -1 for removed element.
+type DiffChange struct {
+	Key      interface{}
+	OldIndex int
+	NewIndex int
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// DiffEdit is a step of the edit script returned by `Diff()`
+type DiffEdit struct {
+	Operation DiffOperation
+	DiffChange
+}
+
+// DiffResult is the result of `Diff()`.
+//  Added    []DiffChange // ==> description: elements which key only exists in the other slice, ordered by `NewIndex`
+//  Removed  []DiffChange // ==> description: elements which key only exists in `data`, ordered by `OldIndex`
+//  Moved    []DiffChange // ==> description: elements which position is changed relative to the other elements, ordered by `NewIndex`
+//  Modified []DiffChange // ==> description: elements which value is changed (using deep equality), ordered by `NewIndex`
+//  Edits    []DiffEdit   // ==> description: the shortest edit script to transform `data` into the other slice, based on the longest common subsequence of keys.
+//                        //                  moved element is deleted then inserted
+type DiffResult struct {
+	Added    []DiffChange
+	Removed  []DiffChange
+	Moved    []DiffChange
+	Modified []DiffChange
+	Edits    []DiffEdit
+}
+
+// _diffKeys gets the key of each element, and the index of each key. Key should be comparable and unique.
+func _diffKeys(err *error, label string, dataValue reflect.Value, keyOf func(reflect.Value) reflect.Value) ([]interface{}, map[interface{}]int) {
+	dataValueLen := dataValue.Len()
+
+	keys := make([]interface{}, 0, dataValueLen)
+	indexes := make(map[interface{}]int, dataValueLen)
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		key := indirectInterface(keyOf(each))
+
+		keyInterface := interface{}(nil)
+		if key.IsValid() {
+			// comparable type, e.g. struct with interface field, can still hold non-comparable value
+			if unhashable := unhashableType(key); unhashable != nil {
+				*err = fmt.Errorf("key should be comparable, got %s", unhashable)
+				return false
+			}
+
+			keyInterface = key.Interface()
+		}
+
+		if _, ok := indexes[keyInterface]; ok {
+			*err = fmt.Errorf("key %v is duplicated in %s", keyInterface, label)
+			return false
+		}
+
+		keys = append(keys, keyInterface)
+		indexes[keyInterface] = i
+		return true
+	})
+
+	return keys, indexes
+}
+
+// _diffLCS gets the index pairs of the longest common subsequence of `oldKeys` and `newKeys`.
+// The common prefix and suffix are skipped first. Since keys are unique, the LCS of the rest is the longest increasing subsequence
+// of the positions in `newKeys` of the common keys, taken in the order of `oldKeys`. It takes O(n log n) time and O(n) space.
+func _diffLCS(oldKeys, newKeys []interface{}) [][2]int {
+	prefix := 0
+	for prefix < len(oldKeys) && prefix < len(newKeys) && oldKeys[prefix] == newKeys[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(oldKeys)-prefix && suffix < len(newKeys)-prefix && oldKeys[len(oldKeys)-1-suffix] == newKeys[len(newKeys)-1-suffix] {
+		suffix++
+	}
+
+	pairs := make([][2]int, 0)
+	for i := 0; i < prefix; i++ {
+		pairs = append(pairs, [2]int{i, i})
+	}
+
+	a, b := oldKeys[prefix:len(oldKeys)-suffix], newKeys[prefix:len(newKeys)-suffix]
+
+	positions := make(map[interface{}]int, len(b))
+	for j, key := range b {
+		positions[key] = j
+	}
+
+	common := make([][2]int, 0)
+	for i, key := range a {
+		if j, ok := positions[key]; ok {
+			common = append(common, [2]int{i, j})
+		}
+	}
+
+	// tails[k] is the index in `common` of the smallest tail of increasing subsequence with length k+1,
+	// and previous[c] is the index of the element before `common[c]` in its subsequence
+	tails := make([]int, 0)
+	previous := make([]int, len(common))
+	for c, pair := range common {
+		k := sort.Search(len(tails), func(k int) bool {
+			return common[tails[k]][1] >= pair[1]
+		})
+
+		previous[c] = -1
+		if k > 0 {
+			previous[c] = tails[k-1]
+		}
+
+		if k == len(tails) {
+			tails = append(tails, c)
+		} else {
+			tails[k] = c
+		}
+	}
+
+	lcs := make([][2]int, len(tails))
+	if len(tails) > 0 {
+		for k, c := len(tails)-1, tails[len(tails)-1]; k >= 0; k, c = k-1, previous[c] {
+			lcs[k] = [2]int{prefix + common[c][0], prefix + common[c][1]}
+		}
+	}
+
+	pairs = append(pairs, lcs...)
+
+	for k := suffix; k > 0; k-- {
+		pairs = append(pairs, [2]int{len(oldKeys) - k, len(newKeys) - k})
+	}
+
+	return pairs
+}
+
+func _diff(err *error, data, other, keyFn interface{}) interface{} {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	if !isNonNilData(err, "data to compare", other) {
+		return nil
+	}
+
+	otherValue, _, _, otherValueLen := inspectData(other)
+
+	if !isSlice(err, "data to compare", otherValue) {
+		return nil
+	}
+
+	if otherValue.Type().Elem() != dataValue.Type().Elem() {
+		*err = errors.New("data type of each elements between slice must be same")
+		return nil
+	}
+
+	keyOf := elementIteratee(err, "element", dataValue.Type().Elem(), []interface{}{keyFn}, false)
+	if *err != nil {
+		return nil
+	}
+
+	oldKeys, oldIndexes := _diffKeys(err, "data", dataValue, keyOf)
+	if *err != nil {
+		return nil
+	}
+
+	newKeys, newIndexes := _diffKeys(err, "data to compare", otherValue, keyOf)
+	if *err != nil {
+		return nil
+	}
+
+	result := DiffResult{
+		Added:    make([]DiffChange, 0),
+		Removed:  make([]DiffChange, 0),
+		Moved:    make([]DiffChange, 0),
+		Modified: make([]DiffChange, 0),
+		Edits:    make([]DiffEdit, 0),
+	}
+
+	change := func(oldIndex, newIndex int) DiffChange {
+		change := DiffChange{OldIndex: oldIndex, NewIndex: newIndex}
+		if oldIndex >= 0 {
+			change.Key = oldKeys[oldIndex]
+			change.OldValue = dataValue.Index(oldIndex).Interface()
+		}
+		if newIndex >= 0 {
+			change.Key = newKeys[newIndex]
+			change.NewValue = otherValue.Index(newIndex).Interface()
+		}
+
+		return change
+	}
+
+	pairs := _diffLCS(oldKeys, newKeys)
+	isCommon := make(map[int]bool, len(pairs))
+
+	i, j := 0, 0
+	for _, pair := range append(pairs, [2]int{dataValueLen, otherValueLen}) {
+		for ; i < pair[0]; i++ {
+			result.Edits = append(result.Edits, DiffEdit{Operation: DiffDelete, DiffChange: change(i, -1)})
+		}
+
+		for ; j < pair[1]; j++ {
+			result.Edits = append(result.Edits, DiffEdit{Operation: DiffInsert, DiffChange: change(-1, j)})
+		}
+
+		if i == dataValueLen && j == otherValueLen {
+			break
+		}
+
+		edit := DiffEdit{Operation: DiffEqual, DiffChange: change(i, j)}
+		if !reflect.DeepEqual(edit.OldValue, edit.NewValue) {
+			edit.Operation = DiffModify
+		}
+
+		result.Edits = append(result.Edits, edit)
+		isCommon[i] = true
+		i++
+		j++
+	}
+
+	for i, key := range oldKeys {
+		if _, ok := newIndexes[key]; !ok {
+			result.Removed = append(result.Removed, change(i, -1))
+		}
+	}
+
+	for j, key := range newKeys {
+		i, ok := oldIndexes[key]
+		if !ok {
+			result.Added = append(result.Added, change(-1, j))
+			continue
+		}
+
+		if !isCommon[i] {
+			result.Moved = append(result.Moved, change(i, j))
+		}
+
+		if each := change(i, j); !reflect.DeepEqual(each.OldValue, each.NewValue) {
+			result.Modified = append(result.Modified, each)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,34 @@
+package gubrak
+
+import (
+	"fmt"
+)
+
+func ExampleChainable_Diff_diff1() {
+	type Member struct {
+		ID   int
+		Role string
+	}
+
+	before := []Member{{1, "admin"}, {2, "editor"}, {3, "viewer"}}
+	after := []Member{{1, "admin"}, {3, "editor"}, {4, "viewer"}}
+
+	result, err := From(before).
+		Diff(after, func(each Member) int {
+			return each.ID
+		}).
+		ResultAndError()
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	diff := result.(DiffResult)
+	for _, each := range diff.Edits {
+		fmt.Println(each.Operation, each.Key)
+	}
+	// ===> equal 1
+	// ===> delete 2
+	// ===> modify 3
+	// ===> insert 4
+}
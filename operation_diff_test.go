@@ -0,0 +1,173 @@
+package gubrak
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	type Item struct {
+		ID    int
+		Name  string
+		Price float64
+	}
+
+	oldItems := []Item{
+		{ID: 1, Name: "apple", Price: 1},
+		{ID: 2, Name: "banana", Price: 2},
+		{ID: 3, Name: "cherry", Price: 3},
+		{ID: 4, Name: "durian", Price: 4},
+	}
+	newItems := []Item{
+		{ID: 1, Name: "apple", Price: 1},
+		{ID: 3, Name: "cherry", Price: 3.5},
+		{ID: 5, Name: "elderberry", Price: 5},
+		{ID: 2, Name: "banana", Price: 2},
+	}
+
+	result, err := From(oldItems).
+		Diff(newItems, func(each Item) int {
+			return each.ID
+		}).
+		ResultAndError()
+	assert.Nil(t, err)
+
+	diff := result.(DiffResult)
+	assert.EqualValues(t, []DiffChange{
+		{Key: 5, OldIndex: -1, NewIndex: 2, NewValue: newItems[2]},
+	}, diff.Added)
+	assert.EqualValues(t, []DiffChange{
+		{Key: 4, OldIndex: 3, NewIndex: -1, OldValue: oldItems[3]},
+	}, diff.Removed)
+	assert.EqualValues(t, []DiffChange{
+		{Key: 2, OldIndex: 1, NewIndex: 3, OldValue: oldItems[1], NewValue: newItems[3]},
+	}, diff.Moved)
+	assert.EqualValues(t, []DiffChange{
+		{Key: 3, OldIndex: 2, NewIndex: 1, OldValue: oldItems[2], NewValue: newItems[1]},
+	}, diff.Modified)
+
+	operations := make([]string, 0)
+	for _, edit := range diff.Edits {
+		operations = append(operations, edit.Operation.String())
+	}
+	assert.EqualValues(t, []string{"equal", "delete", "modify", "delete", "insert", "insert"}, operations)
+	assert.EqualValues(t, []int{0, 1, 2, 3, -1, -1}, From(diff.Edits).Map(func(each DiffEdit) int {
+		return each.OldIndex
+	}).Result())
+	assert.EqualValues(t, []int{0, -1, 1, -1, 2, 3}, From(diff.Edits).Map(func(each DiffEdit) int {
+		return each.NewIndex
+	}).Result())
+}
+
+func TestDiffWithoutKeyFn(t *testing.T) {
+	result, err := From([]string{"a", "b", "c", "d"}).Diff([]string{"a", "c", "d", "b", "e"}, nil).ResultAndError()
+	assert.Nil(t, err)
+
+	diff := result.(DiffResult)
+	assert.Len(t, diff.Added, 1)
+	assert.Equal(t, "e", diff.Added[0].Key)
+	assert.Len(t, diff.Removed, 0)
+	assert.Len(t, diff.Modified, 0)
+	assert.Len(t, diff.Moved, 1)
+	assert.Equal(t, "b", diff.Moved[0].Key)
+	assert.Len(t, diff.Edits, 6)
+}
+
+func TestDiffEditScriptTransformsData(t *testing.T) {
+	oldData := []int{1, 2, 3, 4, 5, 6, 7}
+	newData := []int{0, 2, 4, 3, 6, 8, 7, 1}
+
+	result, err := From(oldData).Diff(newData, nil).ResultAndError()
+	assert.Nil(t, err)
+
+	transformed := make([]int, 0)
+	common := 0
+	for _, edit := range result.(DiffResult).Edits {
+		switch edit.Operation {
+		case DiffEqual, DiffModify:
+			common++
+			transformed = append(transformed, edit.NewValue.(int))
+		case DiffInsert:
+			transformed = append(transformed, edit.NewValue.(int))
+		}
+	}
+
+	assert.EqualValues(t, newData, transformed)
+	assert.Equal(t, 4, common)
+}
+
+func TestDiffLargeReorder(t *testing.T) {
+	oldData := make([]int, 10000)
+	newData := make([]int, 10000)
+	for i := range oldData {
+		oldData[i] = i
+		newData[len(newData)-1-i] = i
+	}
+
+	result, err := From(oldData).Diff(newData, nil).ResultAndError()
+	assert.Nil(t, err)
+
+	diff := result.(DiffResult)
+	assert.Len(t, diff.Added, 0)
+	assert.Len(t, diff.Removed, 0)
+	assert.Len(t, diff.Moved, 9999)
+	assert.Len(t, diff.Edits, 2*9999+1)
+}
+
+func TestDiffIdentical(t *testing.T) {
+	data := []int{1, 2, 3}
+	result, err := From(data).Diff([]int{1, 2, 3}, nil).ResultAndError()
+	assert.Nil(t, err)
+
+	diff := result.(DiffResult)
+	assert.Len(t, diff.Added, 0)
+	assert.Len(t, diff.Removed, 0)
+	assert.Len(t, diff.Moved, 0)
+	assert.Len(t, diff.Modified, 0)
+	assert.Len(t, diff.Edits, 3)
+}
+
+func TestDiffEmptyData(t *testing.T) {
+	result, err := From([]int{}).Diff([]int{1, 2}, nil).ResultAndError()
+	assert.Nil(t, err)
+
+	diff := result.(DiffResult)
+	assert.Len(t, diff.Added, 2)
+	assert.EqualValues(t, []DiffEdit{
+		{Operation: DiffInsert, DiffChange: DiffChange{Key: 1, OldIndex: -1, NewIndex: 0, NewValue: 1}},
+		{Operation: DiffInsert, DiffChange: DiffChange{Key: 2, OldIndex: -1, NewIndex: 1, NewValue: 2}},
+	}, diff.Edits)
+}
+
+func TestDiffDuplicatedKey(t *testing.T) {
+	_, err := From([]int{1, 2}).Diff([]int{1, 2, 1}, nil).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "key 1 is duplicated in data to compare")
+}
+
+func TestDiffIncomparableKey(t *testing.T) {
+	_, err := From([][]int{{1}}).Diff([][]int{{1}}, nil).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "key should be comparable, got []int")
+}
+
+func TestDiffIncomparableDynamicKey(t *testing.T) {
+	type Key struct {
+		V interface{}
+	}
+
+	_, err := From([]Key{{V: 1}}).Diff([]Key{{V: []int{1}}}, nil).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "key should be comparable, got []int")
+}
+
+func TestDiffDifferentDataType(t *testing.T) {
+	_, err := From([]int{1}).Diff([]string{"1"}, nil).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "data type of each elements between slice must be same")
+}
@@ -0,0 +1,1279 @@
+package gubrak
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Expression is a compiled expression, that can be used in place of callback on these operations: `Filter()`, `Find()`, `Reject()` and `OrderBy()`.
+// The expression is evaluated against each element of the data, by reflection. Struct fields and map keys are accessible by name, e.g. `Age` or `Address.City`, while `it` refers to the element itself.
+//
+// The expression language supports:
+//  literals    // ==> 18, 2.5, 'text', "text", true, false, nil, ['ID', 'SG']
+//  arithmetic  // ==> + - * / %
+//  comparison  // ==> == != < <= > >=
+//  boolean     // ==> && || ! (or the keywords: and, or, not)
+//  membership  // ==> Country in ['ID', 'SG'], 'go' in Name
+//  regex       // ==> Name matches '^[A-Z]'
+//  functions   // ==> len(x), lower(s), upper(s), trim(s), contains(s, sub), startsWith(s, prefix), endsWith(s, suffix), abs(n)
+type Expression struct {
+	source string
+	root   exprNode
+	err    error
+}
+
+// ExprError is the error returned when an expression is failed to be parsed, type checked, or evaluated.
+// The `Kind` is one of "syntax", "type" or "runtime".
+type ExprError struct {
+	Kind    string
+	Source  string
+	Offset  int
+	Line    int
+	Column  int
+	Message string
+}
+
+func newExprError(kind, source string, offset int, format string, args ...interface{}) *ExprError {
+	line, column := 1, 1
+	for i, char := range source {
+		if i >= offset {
+			break
+		}
+
+		if char == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+
+	return &ExprError{
+		Kind:    kind,
+		Source:  source,
+		Offset:  offset,
+		Line:    line,
+		Column:  column,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *ExprError) Error() string {
+	return fmt.Sprintf("%s error at line %d, column %d: %s", e.Kind, e.Line, e.Column, e.Message)
+}
+
+// Snippet returns the offending line of the expression, with a caret pointing at the error position
+func (e *ExprError) Snippet() string {
+	lines := strings.Split(e.Source, "\n")
+	if e.Line < 1 || e.Line > len(lines) {
+		return ""
+	}
+
+	return lines[e.Line-1] + "\n" + strings.Repeat(" ", e.Column-1) + "^"
+}
+
+// CompileExpr function parses and type checks the expression. The returned error is `*ExprError`.
+func CompileExpr(source string) (*Expression, error) {
+	parser := &exprParser{source: source}
+	if err := parser.tokenize(); err != nil {
+		return nil, err
+	}
+
+	root, err := parser.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	expression := &Expression{source: source, root: root}
+	if _, err := expression.check(nil); err != nil {
+		return nil, err
+	}
+
+	return expression, nil
+}
+
+// Expr function compiles the expression. Unlike `CompileExpr()`, the compile error is not returned, instead it will be reported by the operation that uses the expression.
+//  From(data).Filter(Expr("Age >= 18 && Country in ['ID', 'SG']"))
+func Expr(source string) *Expression {
+	expression, err := CompileExpr(source)
+	if err != nil {
+		return &Expression{source: source, err: err}
+	}
+
+	return expression
+}
+
+// String returns the source of the expression
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Err returns the compile error of the expression, if any
+func (e *Expression) Err() error {
+	return e.err
+}
+
+// Eval function evaluates the expression against `data`. Numbers are returned as float64.
+func (e *Expression) Eval(data interface{}) (result interface{}, err error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if exprErr, ok := r.(*ExprError); ok {
+				err = exprErr
+				return
+			}
+
+			panic(r)
+		}
+	}()
+
+	return e.root.eval(e, reflect.ValueOf(data)), nil
+}
+
+// check does type checking of the expression, against `elemType`. When `elemType` is nil, all identifiers are treated as dynamic values.
+func (e *Expression) check(elemType reflect.Type) (exprType, error) {
+	checker := &exprChecker{expression: e, elemType: elemType}
+	resultType := e.root.check(checker)
+	if checker.err != nil {
+		return exprTypeAny, checker.err
+	}
+
+	return resultType, nil
+}
+
+func bindExpression(err *error, callback interface{}, dataValue reflect.Value, isPredicate bool) interface{} {
+	expression, ok := callback.(*Expression)
+	if !ok {
+		return callback
+	}
+
+	if expression == nil {
+		*err = errors.New("expression cannot be nil")
+		return nil
+	}
+
+	if expression.err != nil {
+		*err = expression.err
+		return nil
+	}
+
+	switch dataValue.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+	default:
+		return callback
+	}
+
+	elemType := dataValue.Type().Elem()
+
+	resultType, errCheck := expression.check(elemType)
+	if errCheck != nil {
+		*err = errCheck
+		return nil
+	}
+
+	var outType reflect.Type
+
+	if isPredicate {
+		if resultType != exprTypeBool && resultType != exprTypeAny {
+			*err = newExprError("type", expression.source, 0, "expression must return bool, found %s", resultType)
+			return nil
+		}
+
+		outType = reflect.TypeOf(true)
+	} else {
+		if dataValue.Kind() == reflect.Map {
+			*err = newExprError("type", expression.source, 0, "expression key cannot be used on map data")
+			return nil
+		}
+
+		outType = resultType.reflectType()
+
+		// the type of dynamic expression is taken from the first element
+		if outType == nil && dataValue.Len() > 0 {
+			res := expression.root.eval(expression, dataValue.Index(0))
+			if res != nil {
+				outType = reflect.TypeOf(res)
+			}
+		}
+
+		if outType == nil {
+			outType = reflect.TypeOf("")
+		}
+	}
+
+	funcType := reflect.FuncOf([]reflect.Type{elemType}, []reflect.Type{outType}, false)
+	return reflect.MakeFunc(funcType, func(args []reflect.Value) []reflect.Value {
+		res := expression.root.eval(expression, args[0])
+		if isPredicate {
+			return []reflect.Value{reflect.ValueOf(exprIsTruthy(res))}
+		}
+
+		if res == nil {
+			return []reflect.Value{reflect.Zero(outType)}
+		}
+
+		// every element must produce the same type as the first one, otherwise the keys cannot be compared
+		if reflect.TypeOf(res) != outType {
+			panic(newExprError("runtime", expression.source, 0, "expression must return %s for every element, found %T", outType, res))
+		}
+
+		return []reflect.Value{reflect.ValueOf(res)}
+	}).Interface()
+}
+
+// ===== types
+
+type exprType string
+
+const (
+	exprTypeAny    exprType = "any"
+	exprTypeNil    exprType = "nil"
+	exprTypeBool   exprType = "bool"
+	exprTypeNumber exprType = "number"
+	exprTypeString exprType = "string"
+	exprTypeList   exprType = "list"
+	exprTypeObject exprType = "object"
+)
+
+func (t exprType) reflectType() reflect.Type {
+	switch t {
+	case exprTypeBool:
+		return reflect.TypeOf(true)
+	case exprTypeNumber:
+		return reflect.TypeOf(float64(0))
+	case exprTypeString:
+		return reflect.TypeOf("")
+	}
+
+	return nil
+}
+
+func (t exprType) is(types ...exprType) bool {
+	if t == exprTypeAny {
+		return true
+	}
+
+	for _, each := range types {
+		if t == each {
+			return true
+		}
+	}
+
+	return false
+}
+
+func exprTypeOf(valueType reflect.Type) exprType {
+	valueType = indirectType(valueType)
+
+	switch {
+	case isNumberKind(valueType.Kind()):
+		return exprTypeNumber
+	case valueType.Kind() == reflect.String:
+		return exprTypeString
+	case valueType.Kind() == reflect.Bool:
+		return exprTypeBool
+	case valueType.Kind() == reflect.Slice || valueType.Kind() == reflect.Array:
+		return exprTypeList
+	case valueType.Kind() == reflect.Struct && valueType.String() != "time.Time":
+		return exprTypeObject
+	}
+
+	return exprTypeAny
+}
+
+type exprChecker struct {
+	expression *Expression
+	elemType   reflect.Type
+	err        error
+}
+
+func (c *exprChecker) fail(offset int, format string, args ...interface{}) exprType {
+	if c.err == nil {
+		c.err = newExprError("type", c.expression.source, offset, format, args...)
+	}
+
+	return exprTypeAny
+}
+
+// ===== lexer
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenNumber
+	exprTokenString
+	exprTokenIdent
+	exprTokenOperator
+)
+
+type exprToken struct {
+	kind   exprTokenKind
+	text   string
+	value  interface{}
+	offset int
+}
+
+func (t exprToken) String() string {
+	if t.kind == exprTokenEOF {
+		return "end of expression"
+	}
+
+	return strconv.Quote(t.text)
+}
+
+var exprOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "+", "-", "*", "/", "%", "(", ")", "[", "]", ",", "."}
+
+type exprParser struct {
+	source string
+	tokens []exprToken
+	cursor int
+}
+
+func (p *exprParser) tokenize() error {
+	source := p.source
+	i := 0
+
+	for i < len(source) {
+		char := rune(source[i])
+
+		switch {
+		case unicode.IsSpace(char):
+			i++
+
+		case unicode.IsDigit(char):
+			start := i
+			for i < len(source) && (unicode.IsDigit(rune(source[i])) || source[i] == '.' || source[i] == '_') {
+				i++
+			}
+
+			if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+				i++
+				if i < len(source) && (source[i] == '+' || source[i] == '-') {
+					i++
+				}
+
+				for i < len(source) && unicode.IsDigit(rune(source[i])) {
+					i++
+				}
+			}
+
+			text := source[start:i]
+			value, err := strconv.ParseFloat(strings.Replace(text, "_", "", -1), 64)
+			if err != nil {
+				return newExprError("syntax", source, start, "invalid number %q", text)
+			}
+
+			p.tokens = append(p.tokens, exprToken{kind: exprTokenNumber, text: text, value: value, offset: start})
+
+		case char == '\'' || char == '"':
+			start := i
+			quote := source[i]
+			i++
+
+			var buffer strings.Builder
+			isClosed := false
+
+			for i < len(source) {
+				if source[i] == '\\' && i+1 < len(source) {
+					switch source[i+1] {
+					case 'n':
+						buffer.WriteByte('\n')
+					case 't':
+						buffer.WriteByte('\t')
+					default:
+						buffer.WriteByte(source[i+1])
+					}
+
+					i += 2
+					continue
+				}
+
+				if source[i] == quote {
+					isClosed = true
+					i++
+					break
+				}
+
+				buffer.WriteByte(source[i])
+				i++
+			}
+
+			if !isClosed {
+				return newExprError("syntax", source, start, "unterminated string literal")
+			}
+
+			p.tokens = append(p.tokens, exprToken{kind: exprTokenString, text: source[start:i], value: buffer.String(), offset: start})
+
+		case unicode.IsLetter(char) || char == '_':
+			start := i
+			for i < len(source) && (unicode.IsLetter(rune(source[i])) || unicode.IsDigit(rune(source[i])) || source[i] == '_') {
+				i++
+			}
+
+			p.tokens = append(p.tokens, exprToken{kind: exprTokenIdent, text: source[start:i], offset: start})
+
+		default:
+			matched := ""
+			for _, operator := range exprOperators {
+				if strings.HasPrefix(source[i:], operator) {
+					matched = operator
+					break
+				}
+			}
+
+			if matched == "" {
+				return newExprError("syntax", source, i, "unexpected character %q", string(char))
+			}
+
+			p.tokens = append(p.tokens, exprToken{kind: exprTokenOperator, text: matched, offset: i})
+			i += len(matched)
+		}
+	}
+
+	p.tokens = append(p.tokens, exprToken{kind: exprTokenEOF, offset: len(source)})
+	return nil
+}
+
+// ===== parser
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.cursor]
+}
+
+func (p *exprParser) next() exprToken {
+	token := p.tokens[p.cursor]
+	if token.kind != exprTokenEOF {
+		p.cursor++
+	}
+
+	return token
+}
+
+func (p *exprParser) isOperator(texts ...string) bool {
+	token := p.peek()
+	if token.kind != exprTokenOperator {
+		return false
+	}
+
+	for _, text := range texts {
+		if token.text == text {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *exprParser) isKeyword(texts ...string) bool {
+	token := p.peek()
+	if token.kind != exprTokenIdent {
+		return false
+	}
+
+	for _, text := range texts {
+		if token.text == text {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *exprParser) expect(text string) (exprToken, error) {
+	if !p.isOperator(text) {
+		token := p.peek()
+		return token, newExprError("syntax", p.source, token.offset, "expected %q, found %s", text, token)
+	}
+
+	return p.next(), nil
+}
+
+func (p *exprParser) parse() (exprNode, error) {
+	if p.peek().kind == exprTokenEOF {
+		return nil, newExprError("syntax", p.source, 0, "expression cannot be empty")
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := p.peek(); token.kind != exprTokenEOF {
+		return nil, newExprError("syntax", p.source, token.offset, "unexpected %s", token)
+	}
+
+	return node, nil
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("||") || p.isKeyword("or") {
+		token := p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &exprBinary{operator: "||", left: left, right: right, offset: token.offset}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("&&") || p.isKeyword("and") {
+		token := p.next()
+
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &exprBinary{operator: "&&", left: left, right: right, offset: token.offset}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isOperator("==", "!=", "<", "<=", ">", ">=") || p.isKeyword("in", "matches") {
+		token := p.next()
+
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &exprBinary{operator: token.text, left: left, right: right, offset: token.offset}
+
+		if p.isOperator("==", "!=", "<", "<=", ">", ">=") || p.isKeyword("in", "matches") {
+			token := p.peek()
+			return nil, newExprError("syntax", p.source, token.offset, "comparison operators cannot be chained, use parentheses")
+		}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseAdditive() (exprNode, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("+", "-") {
+		token := p.next()
+
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &exprBinary{operator: token.text, left: left, right: right, offset: token.offset}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseMultiplicative() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("*", "/", "%") {
+		token := p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &exprBinary{operator: token.text, left: left, right: right, offset: token.offset}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.isOperator("!", "-") || p.isKeyword("not") {
+		token := p.next()
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		operator := token.text
+		if operator == "not" {
+			operator = "!"
+		}
+
+		return &exprUnary{operator: operator, operand: operand, offset: token.offset}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	token := p.next()
+
+	switch token.kind {
+	case exprTokenNumber, exprTokenString:
+		return &exprLiteral{value: token.value, offset: token.offset}, nil
+
+	case exprTokenIdent:
+		switch token.text {
+		case "true", "false":
+			return &exprLiteral{value: token.text == "true", offset: token.offset}, nil
+		case "nil", "null":
+			return &exprLiteral{value: nil, offset: token.offset}, nil
+		case "and", "or", "not", "in", "matches":
+			return nil, newExprError("syntax", p.source, token.offset, "unexpected keyword %s", token)
+		}
+
+		if p.isOperator("(") {
+			return p.parseCall(token)
+		}
+
+		path := token.text
+		for p.isOperator(".") {
+			p.next()
+
+			segment := p.next()
+			if segment.kind != exprTokenIdent {
+				return nil, newExprError("syntax", p.source, segment.offset, "expected field name after \".\", found %s", segment)
+			}
+
+			path += "." + segment.text
+		}
+
+		return &exprIdent{path: path, offset: token.offset}, nil
+
+	case exprTokenOperator:
+		switch token.text {
+		case "(":
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := p.expect(")"); err != nil {
+				return nil, err
+			}
+
+			return node, nil
+
+		case "[":
+			list := &exprList{offset: token.offset}
+			for !p.isOperator("]") {
+				item, err := p.parseOr()
+				if err != nil {
+					return nil, err
+				}
+
+				list.items = append(list.items, item)
+
+				if !p.isOperator(",") {
+					break
+				}
+
+				p.next()
+			}
+
+			if _, err := p.expect("]"); err != nil {
+				return nil, err
+			}
+
+			return list, nil
+		}
+	}
+
+	return nil, newExprError("syntax", p.source, token.offset, "unexpected %s", token)
+}
+
+func (p *exprParser) parseCall(name exprToken) (exprNode, error) {
+	if _, ok := exprFunctions[name.text]; !ok {
+		return nil, newExprError("syntax", p.source, name.offset, "unknown function %s", name)
+	}
+
+	p.next()
+
+	call := &exprCall{name: name.text, offset: name.offset}
+	for !p.isOperator(")") {
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		call.args = append(call.args, arg)
+
+		if !p.isOperator(",") {
+			break
+		}
+
+		p.next()
+	}
+
+	if _, err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// ===== nodes
+
+type exprNode interface {
+	check(c *exprChecker) exprType
+	eval(e *Expression, elem reflect.Value) interface{}
+}
+
+type exprLiteral struct {
+	value  interface{}
+	offset int
+}
+
+type exprIdent struct {
+	path   string
+	offset int
+}
+
+type exprList struct {
+	items  []exprNode
+	offset int
+}
+
+type exprUnary struct {
+	operator string
+	operand  exprNode
+	offset   int
+}
+
+type exprBinary struct {
+	operator string
+	left     exprNode
+	right    exprNode
+	offset   int
+}
+
+type exprCall struct {
+	name   string
+	args   []exprNode
+	offset int
+}
+
+func (n *exprLiteral) check(c *exprChecker) exprType {
+	switch n.value.(type) {
+	case float64:
+		return exprTypeNumber
+	case string:
+		return exprTypeString
+	case bool:
+		return exprTypeBool
+	}
+
+	return exprTypeNil
+}
+
+func (n *exprLiteral) eval(e *Expression, elem reflect.Value) interface{} {
+	return n.value
+}
+
+func (n *exprIdent) check(c *exprChecker) exprType {
+	if c.elemType == nil || c.elemType.Kind() == reflect.Interface {
+		return exprTypeAny
+	}
+
+	if n.path == "it" {
+		return exprTypeOf(c.elemType)
+	}
+
+	fieldType, err := fieldTypeByPath(c.elemType, strings.TrimPrefix(n.path, "it."))
+	if err != nil {
+		return c.fail(n.offset, "%s", err.Error())
+	}
+
+	return exprTypeOf(fieldType)
+}
+
+func (n *exprIdent) eval(e *Expression, elem reflect.Value) interface{} {
+	if n.path == "it" {
+		return exprNormalize(elem)
+	}
+
+	value, err := fieldValueByPath(elem, strings.TrimPrefix(n.path, "it."))
+	if err != nil {
+		panic(newExprError("runtime", e.source, n.offset, "%s", err.Error()))
+	}
+
+	return exprNormalize(value)
+}
+
+func (n *exprList) check(c *exprChecker) exprType {
+	for _, item := range n.items {
+		item.check(c)
+	}
+
+	return exprTypeList
+}
+
+func (n *exprList) eval(e *Expression, elem reflect.Value) interface{} {
+	result := make([]interface{}, 0, len(n.items))
+	for _, item := range n.items {
+		result = append(result, item.eval(e, elem))
+	}
+
+	return result
+}
+
+func (n *exprUnary) check(c *exprChecker) exprType {
+	operandType := n.operand.check(c)
+
+	if n.operator == "!" {
+		if !operandType.is(exprTypeBool) {
+			return c.fail(n.offset, "operator ! not defined on %s", operandType)
+		}
+
+		return exprTypeBool
+	}
+
+	if !operandType.is(exprTypeNumber) {
+		return c.fail(n.offset, "operator - not defined on %s", operandType)
+	}
+
+	return exprTypeNumber
+}
+
+func (n *exprUnary) eval(e *Expression, elem reflect.Value) interface{} {
+	operand := n.operand.eval(e, elem)
+
+	if n.operator == "!" {
+		value, ok := operand.(bool)
+		if !ok {
+			panic(newExprError("runtime", e.source, n.offset, "operator ! not defined on %T", operand))
+		}
+
+		return !value
+	}
+
+	if operand == nil {
+		return nil
+	}
+
+	value, ok := operand.(float64)
+	if !ok {
+		panic(newExprError("runtime", e.source, n.offset, "operator - not defined on %T", operand))
+	}
+
+	return -value
+}
+
+func (n *exprBinary) check(c *exprChecker) exprType {
+	leftType := n.left.check(c)
+	rightType := n.right.check(c)
+
+	switch n.operator {
+	case "&&", "||":
+		if !leftType.is(exprTypeBool) || !rightType.is(exprTypeBool) {
+			return c.fail(n.offset, "operator %s not defined on %s and %s", n.operator, leftType, rightType)
+		}
+
+		return exprTypeBool
+
+	case "+":
+		switch {
+		case leftType == exprTypeAny && rightType == exprTypeAny:
+			return exprTypeAny
+		case leftType.is(exprTypeNumber) && rightType.is(exprTypeNumber):
+			return exprTypeNumber
+		case leftType.is(exprTypeString) && rightType.is(exprTypeString):
+			return exprTypeString
+		}
+
+		return c.fail(n.offset, "operator + not defined on %s and %s", leftType, rightType)
+
+	case "-", "*", "/", "%":
+		if !leftType.is(exprTypeNumber) || !rightType.is(exprTypeNumber) {
+			return c.fail(n.offset, "operator %s not defined on %s and %s", n.operator, leftType, rightType)
+		}
+
+		return exprTypeNumber
+
+	case "==", "!=":
+		if leftType != exprTypeAny && rightType != exprTypeAny && leftType != exprTypeNil && rightType != exprTypeNil && leftType != rightType {
+			return c.fail(n.offset, "mismatched types %s and %s", leftType, rightType)
+		}
+
+		return exprTypeBool
+
+	case "<", "<=", ">", ">=":
+		isComparable := (leftType.is(exprTypeNumber) && rightType.is(exprTypeNumber)) ||
+			(leftType.is(exprTypeString) && rightType.is(exprTypeString))
+		if !isComparable || leftType == exprTypeList || rightType == exprTypeList {
+			return c.fail(n.offset, "operator %s not defined on %s and %s", n.operator, leftType, rightType)
+		}
+
+		return exprTypeBool
+
+	case "in":
+		if !rightType.is(exprTypeList, exprTypeString) {
+			return c.fail(n.offset, "operator in not defined on %s", rightType)
+		}
+
+		if rightType == exprTypeString && !leftType.is(exprTypeString) {
+			return c.fail(n.offset, "operator in on string requires string operand, found %s", leftType)
+		}
+
+		return exprTypeBool
+
+	case "matches":
+		if !leftType.is(exprTypeString) || !rightType.is(exprTypeString) {
+			return c.fail(n.offset, "operator matches not defined on %s and %s", leftType, rightType)
+		}
+
+		if literal, ok := n.right.(*exprLiteral); ok {
+			if _, err := regexp.Compile(literal.value.(string)); err != nil {
+				return c.fail(literal.offset, "invalid regular expression: %s", err.Error())
+			}
+		}
+
+		return exprTypeBool
+	}
+
+	return c.fail(n.offset, "unknown operator %s", n.operator)
+}
+
+func (n *exprBinary) eval(e *Expression, elem reflect.Value) interface{} {
+	fail := func(format string, args ...interface{}) {
+		panic(newExprError("runtime", e.source, n.offset, format, args...))
+	}
+
+	left := n.left.eval(e, elem)
+
+	switch n.operator {
+	case "&&", "||":
+		leftBool, ok := left.(bool)
+		if !ok {
+			fail("operator %s not defined on %T", n.operator, left)
+		}
+
+		if (n.operator == "&&" && !leftBool) || (n.operator == "||" && leftBool) {
+			return leftBool
+		}
+
+		rightBool, ok := n.right.eval(e, elem).(bool)
+		if !ok {
+			fail("operator %s not defined on %T", n.operator, n.right.eval(e, elem))
+		}
+
+		return rightBool
+	}
+
+	right := n.right.eval(e, elem)
+
+	// missing values propagate through arithmetic, e.g. absent map key
+	if left == nil || right == nil {
+		switch n.operator {
+		case "+", "-", "*", "/", "%":
+			return nil
+		}
+	}
+
+	switch n.operator {
+	case "+":
+		leftNumber, isLeftNumber := left.(float64)
+		rightNumber, isRightNumber := right.(float64)
+		if isLeftNumber && isRightNumber {
+			return leftNumber + rightNumber
+		}
+
+		leftString, isLeftString := left.(string)
+		rightString, isRightString := right.(string)
+		if isLeftString && isRightString {
+			return leftString + rightString
+		}
+
+		fail("operator + not defined on %T and %T", left, right)
+
+	case "-", "*", "/", "%":
+		leftNumber, isLeftNumber := left.(float64)
+		rightNumber, isRightNumber := right.(float64)
+		if !isLeftNumber || !isRightNumber {
+			fail("operator %s not defined on %T and %T", n.operator, left, right)
+		}
+
+		switch n.operator {
+		case "-":
+			return leftNumber - rightNumber
+		case "*":
+			return leftNumber * rightNumber
+		case "/":
+			if rightNumber == 0 {
+				fail("division by zero")
+			}
+
+			return leftNumber / rightNumber
+		}
+
+		if rightNumber == 0 {
+			fail("division by zero")
+		}
+
+		return math.Mod(leftNumber, rightNumber)
+
+	case "==":
+		return isValueEqual(reflect.ValueOf(left), reflect.ValueOf(right))
+
+	case "!=":
+		return !isValueEqual(reflect.ValueOf(left), reflect.ValueOf(right))
+
+	case "<", "<=", ">", ">=":
+		res, ok := compareValues(reflect.ValueOf(left), reflect.ValueOf(right))
+		if !ok || left == nil || right == nil {
+			return false
+		}
+
+		switch n.operator {
+		case "<":
+			return res < 0
+		case "<=":
+			return res <= 0
+		case ">":
+			return res > 0
+		}
+
+		return res >= 0
+
+	case "in":
+		switch rightValue := right.(type) {
+		case []interface{}:
+			for _, each := range rightValue {
+				if isValueEqual(reflect.ValueOf(left), reflect.ValueOf(each)) {
+					return true
+				}
+			}
+
+			return false
+
+		case string:
+			leftString, ok := left.(string)
+			if !ok {
+				fail("operator in on string requires string operand, found %T", left)
+			}
+
+			return strings.Contains(rightValue, leftString)
+
+		case nil:
+			return false
+		}
+
+		fail("operator in not defined on %T", right)
+
+	case "matches":
+		leftString, isLeftString := left.(string)
+		rightString, isRightString := right.(string)
+		if !isLeftString || !isRightString {
+			if left == nil {
+				return false
+			}
+
+			fail("operator matches not defined on %T and %T", left, right)
+		}
+
+		pattern, err := exprCompileRegexp(rightString)
+		if err != nil {
+			fail("invalid regular expression: %s", err.Error())
+		}
+
+		return pattern.MatchString(leftString)
+	}
+
+	fail("unknown operator %s", n.operator)
+	return nil
+}
+
+type exprFunction struct {
+	params []exprType
+	result exprType
+	call   func(args []interface{}) (interface{}, error)
+}
+
+var exprFunctions = map[string]exprFunction{
+	"len": {[]exprType{exprTypeAny}, exprTypeNumber, func(args []interface{}) (interface{}, error) {
+		switch value := args[0].(type) {
+		case string:
+			return float64(len([]rune(value))), nil
+		case []interface{}:
+			return float64(len(value)), nil
+		case nil:
+			return float64(0), nil
+		}
+
+		return nil, fmt.Errorf("len() not defined on %T", args[0])
+	}},
+	"lower":      exprStringFunction(strings.ToLower),
+	"upper":      exprStringFunction(strings.ToUpper),
+	"trim":       exprStringFunction(strings.TrimSpace),
+	"contains":   exprStringPredicateFunction(strings.Contains),
+	"startsWith": exprStringPredicateFunction(strings.HasPrefix),
+	"endsWith":   exprStringPredicateFunction(strings.HasSuffix),
+	"abs": {[]exprType{exprTypeNumber}, exprTypeNumber, func(args []interface{}) (interface{}, error) {
+		value, ok := args[0].(float64)
+		if !ok {
+			return nil, fmt.Errorf("abs() not defined on %T", args[0])
+		}
+
+		return math.Abs(value), nil
+	}},
+}
+
+func exprStringFunction(callback func(string) string) exprFunction {
+	return exprFunction{[]exprType{exprTypeString}, exprTypeString, func(args []interface{}) (interface{}, error) {
+		value, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("argument must be string, found %T", args[0])
+		}
+
+		return callback(value), nil
+	}}
+}
+
+func exprStringPredicateFunction(callback func(string, string) bool) exprFunction {
+	return exprFunction{[]exprType{exprTypeString, exprTypeString}, exprTypeBool, func(args []interface{}) (interface{}, error) {
+		left, isLeftString := args[0].(string)
+		right, isRightString := args[1].(string)
+		if !isLeftString || !isRightString {
+			return nil, fmt.Errorf("arguments must be string, found %T and %T", args[0], args[1])
+		}
+
+		return callback(left, right), nil
+	}}
+}
+
+func (n *exprCall) check(c *exprChecker) exprType {
+	function := exprFunctions[n.name]
+
+	if len(n.args) != len(function.params) {
+		return c.fail(n.offset, "%s() expects %d argument(s), found %d", n.name, len(function.params), len(n.args))
+	}
+
+	for i, arg := range n.args {
+		argType := arg.check(c)
+		if function.params[i] != exprTypeAny && !argType.is(function.params[i]) {
+			return c.fail(n.offset, "%s() argument %d must be %s, found %s", n.name, i+1, function.params[i], argType)
+		}
+	}
+
+	return function.result
+}
+
+func (n *exprCall) eval(e *Expression, elem reflect.Value) interface{} {
+	args := make([]interface{}, 0, len(n.args))
+	for _, arg := range n.args {
+		args = append(args, arg.eval(e, elem))
+	}
+
+	result, err := exprFunctions[n.name].call(args)
+	if err != nil {
+		panic(newExprError("runtime", e.source, n.offset, "%s", err.Error()))
+	}
+
+	return result
+}
+
+// ===== helpers
+
+// exprRegexpCacheLimit is the maximum number of compiled patterns kept in the cache, the cache is cleared once it's full
+const exprRegexpCacheLimit = 256
+
+var exprRegexpCache = struct {
+	sync.RWMutex
+	patterns map[string]*regexp.Regexp
+}{patterns: make(map[string]*regexp.Regexp)}
+
+func exprCompileRegexp(pattern string) (*regexp.Regexp, error) {
+	exprRegexpCache.RLock()
+	compiled, ok := exprRegexpCache.patterns[pattern]
+	exprRegexpCache.RUnlock()
+
+	if ok {
+		return compiled, nil
+	}
+
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	exprRegexpCache.Lock()
+	if len(exprRegexpCache.patterns) >= exprRegexpCacheLimit {
+		exprRegexpCache.patterns = make(map[string]*regexp.Regexp)
+	}
+	exprRegexpCache.patterns[pattern] = compiled
+	exprRegexpCache.Unlock()
+
+	return compiled, nil
+}
+
+func exprNormalize(value reflect.Value) interface{} {
+	value = indirectValue(value)
+	if !value.IsValid() {
+		return nil
+	}
+
+	switch {
+	case isNumberKind(value.Kind()):
+		return numberToFloat(value)
+	case value.Kind() == reflect.String:
+		return value.String()
+	case value.Kind() == reflect.Bool:
+		return value.Bool()
+	case value.Kind() == reflect.Slice || value.Kind() == reflect.Array:
+		result := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			result = append(result, exprNormalize(value.Index(i)))
+		}
+
+		return result
+	}
+
+	return value.Interface()
+}
+
+func exprIsTruthy(value interface{}) bool {
+	switch each := value.(type) {
+	case bool:
+		return each
+	case nil:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,41 @@
+package gubrak
+
+import (
+	"fmt"
+)
+
+func ExampleExpr() {
+	type Person struct {
+		Name    string
+		Age     int
+		Country string
+	}
+
+	data := []Person{
+		{Name: "Jason", Age: 30, Country: "ID"},
+		{Name: "Ethan", Age: 17, Country: "SG"},
+		{Name: "Grace", Age: 25, Country: "US"},
+	}
+
+	result, err := From(data).
+		Filter(Expr("Age >= 18 && Country in ['ID', 'SG']")).
+		ResultAndError()
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	fmt.Println(result)
+	// ===> []Person{ { "Jason", 30, "ID" } }
+}
+
+func ExampleCompileExpr() {
+	_, err := CompileExpr("Age >= && Country == 'ID'")
+	if exprErr, ok := err.(*ExprError); ok {
+		fmt.Println(exprErr.Error())
+		fmt.Println(exprErr.Snippet())
+	}
+	// ===> syntax error at line 1, column 8: unexpected "&&"
+	//      Age >= && Country == 'ID'
+	//             ^
+}
@@ -0,0 +1,312 @@
+package gubrak
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExprFilter(t *testing.T) {
+	type Person struct {
+		Name    string
+		Age     int
+		Country string
+	}
+
+	data := []Person{
+		{Name: "Jason", Age: 30, Country: "ID"},
+		{Name: "Ethan", Age: 17, Country: "SG"},
+		{Name: "grace", Age: 25, Country: "SG"},
+		{Name: "Nathan", Age: 40, Country: "US"},
+	}
+
+	result, err := From(data).
+		Filter(Expr("Age >= 18 && Country in ['ID', 'SG']")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Person{
+		{Name: "Jason", Age: 30, Country: "ID"},
+		{Name: "grace", Age: 25, Country: "SG"},
+	}, result)
+}
+
+func TestExprReject(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+		Tags []string
+	}
+
+	data := []Person{
+		{Name: "Jason", Age: 30, Tags: []string{"admin"}},
+		{Name: "Ethan", Age: 17},
+		{Name: "grace", Age: 25, Tags: []string{"staff", "admin"}},
+		{Name: "Nathan", Age: 40},
+	}
+
+	result, err := From(data).
+		Reject(Expr("'admin' in Tags or Age < 18")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Person{{Name: "Nathan", Age: 40}}, result)
+}
+
+func TestExprFind(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+	}
+
+	type Person struct {
+		Name    string
+		Address Address
+	}
+
+	data := []Person{
+		{Name: "Jason", Address: Address{City: "Jakarta"}},
+		{Name: "Ethan"},
+		{Name: "Nathan", Address: Address{City: "Boston"}},
+	}
+
+	result, err := From(data).
+		Find(Expr("Address.city == 'Boston'")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, Person{Name: "Nathan", Address: Address{City: "Boston"}}, result)
+}
+
+func TestExprTakeWhile(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	data := []Person{
+		{Name: "Jason", Age: 30},
+		{Name: "Ethan", Age: 17},
+		{Name: "grace", Age: 25},
+	}
+
+	result, err := From(data).
+		TakeWhile(Expr("Age >= 18")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Person{{Name: "Jason", Age: 30}}, result)
+}
+
+func TestExprOrderBy(t *testing.T) {
+	data := []string{"Jason", "Ethan", "grace", "Nathan"}
+
+	result, err := From(data).
+		OrderBy(Expr("lower(it)")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"Ethan", "grace", "Jason", "Nathan"}, result)
+}
+
+func TestExprOrderByArithmetic(t *testing.T) {
+	result, err := From([]int{3, 1, 2}).
+		OrderBy(Expr("(it % 3) * -1")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{2, 1, 3}, result)
+}
+
+func TestExprOrderByMixedKeyTypes(t *testing.T) {
+	data := []map[string]interface{}{
+		{"a": 2},
+		{"a": "x"},
+		{"a": 1},
+	}
+
+	result, err := From(data).
+		OrderBy(Expr("a")).
+		ResultAndError()
+
+	assert.Nil(t, result)
+
+	exprErr := new(ExprError)
+	assert.True(t, errors.As(err, &exprErr))
+	assert.Equal(t, "runtime", exprErr.Kind)
+	assert.Contains(t, exprErr.Message, "expression must return float64 for every element, found string")
+}
+
+func TestExprKeyOnMapData(t *testing.T) {
+	err := (error)(nil)
+	data := map[string]int{"a": 1}
+
+	callback := bindExpression(&err, Expr("it"), reflect.ValueOf(data), false)
+
+	assert.Nil(t, callback)
+
+	exprErr := new(ExprError)
+	assert.True(t, errors.As(err, &exprErr))
+	assert.Equal(t, "type", exprErr.Kind)
+}
+
+func TestExprRegexAndStringFunctions(t *testing.T) {
+	type Person struct {
+		Name string
+	}
+
+	data := []Person{{Name: "Jason"}, {Name: "Ethan"}, {Name: "grace"}, {Name: "Nathan"}}
+
+	result, err := From(data).
+		Filter(Expr("Name matches '^[A-Z]' && !endsWith(Name, 'n') || startsWith(upper(trim(Name)), 'GR')")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Person{{Name: "grace"}}, result)
+}
+
+func TestExprMapData(t *testing.T) {
+	data := []map[string]interface{}{
+		{"name": "a", "score": 10},
+		{"name": "b", "score": 25.5},
+		{"name": "c"},
+	}
+
+	result, err := From(data).Filter(Expr("score + 5 > 20")).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{{"name": "b", "score": 25.5}}, result)
+}
+
+func TestExprEval(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	expression, err := CompileExpr("len(Name) * 2 + Age")
+	assert.Nil(t, err)
+
+	result, err := expression.Eval(Person{Name: "Jason", Age: 30})
+
+	assert.Nil(t, err)
+	assert.Equal(t, float64(40), result)
+}
+
+func TestExprSyntaxError(t *testing.T) {
+	_, err := CompileExpr("Age >= && Country")
+
+	exprErr := new(ExprError)
+	assert.True(t, errors.As(err, &exprErr))
+	assert.Equal(t, "syntax", exprErr.Kind)
+	assert.Equal(t, 8, exprErr.Column)
+	assert.EqualError(t, err, `syntax error at line 1, column 8: unexpected "&&"`)
+	assert.Equal(t, "Age >= && Country\n       ^", exprErr.Snippet())
+}
+
+func TestExprSyntaxErrorUnterminatedString(t *testing.T) {
+	_, err := CompileExpr("Name == 'abc")
+
+	assert.EqualError(t, err, "syntax error at line 1, column 9: unterminated string literal")
+}
+
+func TestExprSyntaxErrorMissingParenthesis(t *testing.T) {
+	_, err := CompileExpr("(Age > 1")
+
+	assert.EqualError(t, err, `syntax error at line 1, column 9: expected ")", found end of expression`)
+}
+
+func TestExprSyntaxErrorUnknownFunction(t *testing.T) {
+	_, err := CompileExpr("size(Name) > 1")
+
+	assert.EqualError(t, err, `syntax error at line 1, column 1: unknown function "size"`)
+}
+
+func TestExprTypeErrorOnCompile(t *testing.T) {
+	_, err := CompileExpr("'a' - 1")
+
+	assert.EqualError(t, err, "type error at line 1, column 5: operator - not defined on string and number")
+}
+
+func TestExprTypeErrorAgainstStruct(t *testing.T) {
+	type Person struct {
+		Name string
+	}
+
+	result, err := From([]Person{{Name: "Jason"}}).Filter(Expr("Name > 10")).ResultAndError()
+
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "type error at line 1, column 6: operator > not defined on string and number")
+}
+
+func TestExprUnknownField(t *testing.T) {
+	type Person struct {
+		Name string
+	}
+
+	_, err := From([]Person{{Name: "Jason"}}).Filter(Expr("Salary > 10")).ResultAndError()
+
+	assert.EqualError(t, err, `type error at line 1, column 1: field "Salary" not found in gubrak.Person`)
+}
+
+func TestExprPredicateMustBeBool(t *testing.T) {
+	type Person struct {
+		Age int
+	}
+
+	_, err := From([]Person{{Age: 30}}).Filter(Expr("Age + 1")).ResultAndError()
+
+	assert.EqualError(t, err, "type error at line 1, column 1: expression must return bool, found number")
+}
+
+func TestExprRuntimeError(t *testing.T) {
+	data := []map[string]interface{}{{"a": "x"}}
+
+	_, err := From(data).Filter(Expr("a * 2 > 1")).ResultAndError()
+
+	exprErr := new(ExprError)
+	assert.True(t, errors.As(err, &exprErr))
+	assert.Equal(t, "runtime", exprErr.Kind)
+	assert.EqualError(t, err, "runtime error at line 1, column 3: operator * not defined on string and float64")
+}
+
+func TestExprRuntimeErrorDivisionByZero(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	data := []Person{{Name: "Jason", Age: 30}, {Name: "Ethan", Age: 0}}
+
+	_, err := From(data).Filter(Expr("10 / Age > 0")).ResultAndError()
+
+	exprErr := new(ExprError)
+	assert.True(t, errors.As(err, &exprErr))
+	assert.Equal(t, "runtime", exprErr.Kind)
+	assert.Equal(t, 4, exprErr.Column)
+	assert.EqualError(t, err, "runtime error at line 1, column 4: division by zero")
+}
+
+func TestExprRegexpCacheLimit(t *testing.T) {
+	for i := 0; i < exprRegexpCacheLimit*2; i++ {
+		pattern := fmt.Sprintf("^a1$|^b%d$", i)
+		result, err := From([]string{pattern}).Filter(Expr("'a1' matches it")).ResultAndError()
+
+		assert.Nil(t, err)
+		assert.EqualValues(t, []string{pattern}, result)
+	}
+
+	exprRegexpCache.RLock()
+	defer exprRegexpCache.RUnlock()
+	assert.True(t, len(exprRegexpCache.patterns) <= exprRegexpCacheLimit)
+}
+
+func TestExprCompileErrorReportedByOperation(t *testing.T) {
+	chain := From([]int{1, 2, 3}).Filter(Expr("it >"))
+
+	assert.True(t, chain.IsError())
+	assert.Equal(t, Operation(OperationFilter), chain.LastErrorOperation())
+	assert.EqualError(t, chain.Error(), "syntax error at line 1, column 5: unexpected end of expression")
+}
@@ -0,0 +1,394 @@
+package gubrak
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HTTPQueryOptions configures how query string is translated into operations. Only whitelisted fields can be sorted or filtered,
+// field names are matched against the struct field's json tag (or field name), or against map key.
+//  SortableFields   []string // ==> description: fields allowed on `sort` parameter
+//  FilterableFields []string // ==> description: fields allowed on `filter[...]` parameters
+//  DefaultSort      string   // ==> description: sort used when `sort` parameter is empty, e.g. "-createdAt"
+//  DefaultPerPage   int      // ==> description: page size used when `per_page` parameter is empty. default value: 20
+//  MaxPerPage       int      // ==> description: maximum allowed page size. default value: 100
+type HTTPQueryOptions struct {
+	SortableFields   []string
+	FilterableFields []string
+	DefaultSort      string
+	DefaultPerPage   int
+	MaxPerPage       int
+}
+
+// HTTPQuery is the parsed query string, e.g. `?sort=-createdAt,name&filter[status]=active&filter[age][gte]=18&page=2&per_page=50`
+type HTTPQuery struct {
+	Sorts   []HTTPQuerySort
+	Filters []HTTPQueryFilter
+	Page    int
+	PerPage int
+}
+
+// HTTPQuerySort represents single sort key. Prefix the field with `-` on the query string for descending order.
+type HTTPQuerySort struct {
+	Field        string
+	IsDescending bool
+}
+
+// HTTPQueryFilter represents single filter. The operator is one of: eq, ne, gt, gte, lt, lte. Multiple comma separated values on `eq` or `ne` are treated as any of the values.
+type HTTPQueryFilter struct {
+	Field    string
+	Operator string
+	Values   []string
+}
+
+// HTTPQueryResult is the response envelope, containing the page of data and the total counts.
+type HTTPQueryResult struct {
+	Data       interface{} `json:"data"`
+	Total      int         `json:"total"`
+	Count      int         `json:"count"`
+	Page       int         `json:"page"`
+	PerPage    int         `json:"per_page"`
+	TotalPages int         `json:"total_pages"`
+}
+
+// HTTPQueryError is the error returned when the query string is invalid, usually answered with http status 400 Bad Request.
+type HTTPQueryError struct {
+	Param   string
+	Message string
+}
+
+func (e *HTTPQueryError) Error() string {
+	return fmt.Sprintf("invalid query parameter %s: %s", e.Param, e.Message)
+}
+
+var httpQueryFilterPattern = regexp.MustCompile(`^filter\[([^\[\]]+)\](?:\[([a-z]+)\])?$`)
+
+var httpQueryOperators = map[string]bool{"eq": true, "ne": true, "gt": true, "gte": true, "lt": true, "lte": true}
+
+// FromHTTPQuery function parses the query string then applies it to `data`. This is a shorthand of `ParseHTTPQuery()` followed by `.Apply()`.
+func FromHTTPQuery(data interface{}, query url.Values, options HTTPQueryOptions) (*HTTPQueryResult, error) {
+	httpQuery, err := ParseHTTPQuery(query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpQuery.Apply(data)
+}
+
+// ParseHTTPQuery function parses `sort`, `filter[...]`, `page` and `per_page` parameters of the query string. The returned error is `*HTTPQueryError`.
+func ParseHTTPQuery(query url.Values, options HTTPQueryOptions) (*HTTPQuery, error) {
+	if options.DefaultPerPage <= 0 {
+		options.DefaultPerPage = 20
+	}
+
+	if options.MaxPerPage <= 0 {
+		options.MaxPerPage = 100
+	}
+
+	isAllowed := func(fields []string, field string) bool {
+		for _, each := range fields {
+			if each == field {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	result := &HTTPQuery{Page: 1, PerPage: options.DefaultPerPage}
+
+	sortParam := strings.Join(query["sort"], ",")
+	if strings.TrimSpace(sortParam) == "" {
+		sortParam = options.DefaultSort
+	}
+
+	for _, each := range strings.Split(sortParam, ",") {
+		each = strings.TrimSpace(each)
+		if each == "" {
+			continue
+		}
+
+		sortKey := HTTPQuerySort{Field: strings.TrimPrefix(strings.TrimPrefix(each, "-"), "+")}
+		sortKey.IsDescending = strings.HasPrefix(each, "-")
+
+		if !isAllowed(options.SortableFields, sortKey.Field) {
+			return nil, &HTTPQueryError{Param: "sort", Message: fmt.Sprintf("field %q is not sortable", sortKey.Field)}
+		}
+
+		result.Sorts = append(result.Sorts, sortKey)
+	}
+
+	filterKeys := make([]string, 0)
+	for key := range query {
+		if strings.HasPrefix(key, "filter[") {
+			filterKeys = append(filterKeys, key)
+		}
+	}
+
+	sort.Strings(filterKeys)
+
+	for _, key := range filterKeys {
+		matches := httpQueryFilterPattern.FindStringSubmatch(key)
+		if matches == nil {
+			return nil, &HTTPQueryError{Param: key, Message: "filter must be in format filter[field] or filter[field][operator]"}
+		}
+
+		filter := HTTPQueryFilter{Field: matches[1], Operator: matches[2]}
+		if filter.Operator == "" {
+			filter.Operator = "eq"
+		}
+
+		if !httpQueryOperators[filter.Operator] {
+			return nil, &HTTPQueryError{Param: key, Message: fmt.Sprintf("unknown operator %q", filter.Operator)}
+		}
+
+		if !isAllowed(options.FilterableFields, filter.Field) {
+			return nil, &HTTPQueryError{Param: key, Message: fmt.Sprintf("field %q is not filterable", filter.Field)}
+		}
+
+		for _, value := range query[key] {
+			if filter.Operator == "eq" || filter.Operator == "ne" {
+				filter.Values = append(filter.Values, strings.Split(value, ",")...)
+			} else {
+				filter.Values = append(filter.Values, value)
+			}
+		}
+
+		if len(filter.Values) != 1 && filter.Operator != "eq" && filter.Operator != "ne" {
+			return nil, &HTTPQueryError{Param: key, Message: fmt.Sprintf("operator %s requires single value", filter.Operator)}
+		}
+
+		result.Filters = append(result.Filters, filter)
+	}
+
+	parsePositiveNumber := func(param string, defaultValue int) (int, error) {
+		text := strings.TrimSpace(query.Get(param))
+		if text == "" {
+			return defaultValue, nil
+		}
+
+		value, err := strconv.Atoi(text)
+		if err != nil || value <= 0 {
+			return 0, &HTTPQueryError{Param: param, Message: "must be positive number"}
+		}
+
+		return value, nil
+	}
+
+	var err error
+
+	if result.Page, err = parsePositiveNumber("page", 1); err != nil {
+		return nil, err
+	}
+
+	if result.PerPage, err = parsePositiveNumber("per_page", options.DefaultPerPage); err != nil {
+		return nil, err
+	}
+
+	if result.PerPage > options.MaxPerPage {
+		result.PerPage = options.MaxPerPage
+	}
+
+	return result, nil
+}
+
+// Apply function runs `Filter()`, `OrderBy()`, `Drop()` and `Take()` operations over `data` according to the query, then returns the page of data wrapped in the response envelope.
+func (q *HTTPQuery) Apply(data interface{}) (*HTTPQueryResult, error) {
+	elemType, err := _pipelineElemType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	g := From(data).(*Chainable)
+
+	for _, filter := range q.Filters {
+		fieldType, err := fieldTypeByPath(elemType, filter.Field)
+		if err != nil {
+			return nil, &HTTPQueryError{Param: fmt.Sprintf("filter[%s]", filter.Field), Message: err.Error()}
+		}
+
+		condition, err := filter.condition(fieldType)
+		if err != nil {
+			return nil, err
+		}
+
+		predicate, err := makeFieldPredicate(g.data, condition)
+		if err != nil {
+			return nil, err
+		}
+
+		g.Filter(predicate)
+		if g.IsError() {
+			return nil, g.Error()
+		}
+	}
+
+	if len(q.Sorts) > 0 {
+		for _, sortKey := range q.Sorts {
+			if _, err := fieldTypeByPath(elemType, sortKey.Field); err != nil {
+				return nil, &HTTPQueryError{Param: "sort", Message: err.Error()}
+			}
+		}
+
+		g = From(_sortByFields(g.data, q.Sorts, func(each reflect.Value, field string) reflect.Value {
+			value, _ := fieldValueByPath(each, field)
+			return value
+		})).(*Chainable)
+	}
+
+	_, _, _, total := inspectData(g.data)
+
+	// pages after the last one are empty. the offset is clamped to the total, so large page number does not overflow
+	offset := total
+	if q.Page-1 <= total/q.PerPage {
+		offset = (q.Page - 1) * q.PerPage
+	}
+
+	result, err := g.Drop(offset).Take(q.PerPage).ResultAndError()
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, _, count := inspectData(result)
+
+	return &HTTPQueryResult{
+		Data:       result,
+		Total:      total,
+		Count:      count,
+		Page:       q.Page,
+		PerPage:    q.PerPage,
+		TotalPages: int(math.Ceil(float64(total) / float64(q.PerPage))),
+	}, nil
+}
+
+// _sortByFields sorts `data` by each of `sorts`, the first one is the most significant. The key of each element is taken using `keyOf`.
+// The sort is stable, equal elements keep their original order on both ascending and descending sort.
+func _sortByFields(data interface{}, sorts []HTTPQuerySort, keyOf func(each reflect.Value, field string) reflect.Value) interface{} {
+	dataValue, dataValueType, _, dataValueLen := inspectData(data)
+
+	indexes := make([]int, dataValueLen)
+	keys := make([][]reflect.Value, dataValueLen)
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		indexes[i] = i
+		keys[i] = make([]reflect.Value, len(sorts))
+		for k, sortKey := range sorts {
+			keys[i][k] = keyOf(each, sortKey.Field)
+		}
+	})
+
+	sort.SliceStable(indexes, func(a, b int) bool {
+		for k, sortKey := range sorts {
+			res, ok := compareValues(keys[indexes[a]][k], keys[indexes[b]][k])
+			if ok && res != 0 {
+				return (res < 0) != sortKey.IsDescending
+			}
+		}
+
+		return false
+	})
+
+	result := makeSlice(dataValueType, dataValueLen, dataValueLen)
+	for i, index := range indexes {
+		result.Index(i).Set(dataValue.Index(index))
+	}
+
+	return result.Interface()
+}
+
+func (f HTTPQueryFilter) condition(fieldType reflect.Type) (PipelineCondition, error) {
+	values := make([]interface{}, 0, len(f.Values))
+	for _, each := range f.Values {
+		value, err := parseHTTPQueryValue(each, fieldType)
+		if err != nil {
+			return PipelineCondition{}, &HTTPQueryError{
+				Param:   fmt.Sprintf("filter[%s]", f.Field),
+				Message: err.Error(),
+			}
+		}
+
+		values = append(values, value)
+	}
+
+	condition := PipelineCondition{Field: f.Field}
+
+	switch f.Operator {
+	case "eq":
+		condition.In = values
+	case "ne":
+		condition.Nin = values
+	case "gt":
+		condition.Gt = values[0]
+	case "gte":
+		condition.Gte = values[0]
+	case "lt":
+		condition.Lt = values[0]
+	case "lte":
+		condition.Lte = values[0]
+	}
+
+	return condition, nil
+}
+
+func parseHTTPQueryValue(text string, targetType reflect.Type) (interface{}, error) {
+	targetType = indirectType(targetType)
+
+	if targetType == reflect.TypeOf(time.Time{}) {
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+			if value, err := time.Parse(layout, text); err == nil {
+				return value, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%q is not a valid time", text)
+	}
+
+	switch targetType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid integer", text)
+		}
+
+		return value, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid unsigned integer", text)
+		}
+
+		return value, nil
+
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid number", text)
+		}
+
+		return value, nil
+
+	case reflect.Bool:
+		value, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid boolean", text)
+		}
+
+		return value, nil
+
+	case reflect.Interface:
+		if value, err := strconv.ParseFloat(text, 64); err == nil {
+			return value, nil
+		}
+
+		return text, nil
+	}
+
+	return text, nil
+}
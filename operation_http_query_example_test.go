@@ -0,0 +1,48 @@
+package gubrak
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+func ExampleFromHTTPQuery() {
+	type Article struct {
+		ID        int       `json:"id"`
+		Title     string    `json:"title"`
+		Status    string    `json:"status"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	articles := []Article{
+		{ID: 1, Title: "alpha", Status: "active", CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Title: "bravo", Status: "draft", CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Title: "charlie", Status: "active", CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, Title: "delta", Status: "active", CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	options := HTTPQueryOptions{
+		SortableFields:   []string{"createdAt", "title"},
+		FilterableFields: []string{"status"},
+		DefaultSort:      "-createdAt",
+		DefaultPerPage:   20,
+		MaxPerPage:       100,
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result, err := FromHTTPQuery(articles, r.URL.Query(), options)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		json.NewEncoder(w).Encode(result)
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/articles?sort=-createdAt,title&filter[status]=active&page=1&per_page=2", nil))
+
+	fmt.Print(recorder.Body.String())
+	// Output: {"data":[{"id":3,"title":"charlie","status":"active","createdAt":"2020-01-03T00:00:00Z"},{"id":4,"title":"delta","status":"active","createdAt":"2020-01-03T00:00:00Z"}],"total":3,"count":2,"page":1,"per_page":2,"total_pages":2}
+}
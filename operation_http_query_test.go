@@ -0,0 +1,309 @@
+package gubrak
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPQueryFilterSortPaginate(t *testing.T) {
+	type Article struct {
+		ID        int       `json:"id"`
+		Title     string    `json:"title"`
+		Status    string    `json:"status"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	data := []Article{
+		{ID: 1, Title: "alpha", Status: "active", CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Title: "bravo", Status: "draft", CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Title: "charlie", Status: "active", CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, Title: "delta", Status: "active", CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 5, Title: "foxtrot", Status: "active", CreatedAt: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+	}
+	options := HTTPQueryOptions{
+		SortableFields:   []string{"createdAt", "title"},
+		FilterableFields: []string{"status"},
+	}
+	query, _ := url.ParseQuery("sort=-createdAt,title&filter[status]=active&page=2&per_page=2")
+
+	result, err := FromHTTPQuery(data, query, options)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Article{data[3], data[0]}, result.Data)
+	assert.Equal(t, 4, result.Total)
+	assert.Equal(t, 2, result.Count)
+	assert.Equal(t, 2, result.Page)
+	assert.Equal(t, 2, result.PerPage)
+	assert.Equal(t, 2, result.TotalPages)
+}
+
+func TestHTTPQueryMultiKeySort(t *testing.T) {
+	type Article struct {
+		Title string `json:"title"`
+		Views int    `json:"views"`
+	}
+
+	data := []Article{{"alpha", 10}, {"bravo", 50}, {"charlie", 30}, {"delta", 30}, {"foxtrot", 70}}
+	options := HTTPQueryOptions{SortableFields: []string{"title", "views"}}
+	query, _ := url.ParseQuery("sort=-views,-title&per_page=3")
+
+	result, err := FromHTTPQuery(data, query, options)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Article{{"foxtrot", 70}, {"bravo", 50}, {"delta", 30}}, result.Data)
+	assert.Equal(t, 2, result.TotalPages)
+}
+
+func TestHTTPQuerySortStable(t *testing.T) {
+	type Article struct {
+		Title string `json:"title"`
+		Views int    `json:"views"`
+	}
+
+	data := []Article{{"alpha", 1}, {"bravo", 2}, {"charlie", 1}, {"delta", 2}, {"echo", 1}}
+	options := HTTPQueryOptions{SortableFields: []string{"views"}}
+
+	for raw, expected := range map[string][]Article{
+		"sort=views":  {{"alpha", 1}, {"charlie", 1}, {"echo", 1}, {"bravo", 2}, {"delta", 2}},
+		"sort=-views": {{"bravo", 2}, {"delta", 2}, {"alpha", 1}, {"charlie", 1}, {"echo", 1}},
+	} {
+		query, _ := url.ParseQuery(raw)
+
+		result, err := FromHTTPQuery(data, query, options)
+
+		assert.Nil(t, err, raw)
+		assert.EqualValues(t, expected, result.Data, raw)
+	}
+}
+
+func TestHTTPQueryUnknownSortField(t *testing.T) {
+	type Article struct {
+		Title string `json:"title"`
+	}
+
+	query, _ := url.ParseQuery("sort=views")
+
+	_, err := FromHTTPQuery([]Article{{"alpha"}}, query, HTTPQueryOptions{SortableFields: []string{"views"}})
+
+	queryErr := new(HTTPQueryError)
+	assert.True(t, errors.As(err, &queryErr))
+	assert.Equal(t, "sort", queryErr.Param)
+}
+
+func TestHTTPQueryFilterOperators(t *testing.T) {
+	type Article struct {
+		ID        int       `json:"id"`
+		Status    string    `json:"status"`
+		Views     int       `json:"views"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	data := []Article{
+		{ID: 1, Status: "active", Views: 10, CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Status: "draft", Views: 50, CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, Status: "active", Views: 30, CreatedAt: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: 4, Status: "archived", Views: 40, CreatedAt: time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{ID: 5, Status: "active", Views: 70, CreatedAt: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+	}
+	options := HTTPQueryOptions{FilterableFields: []string{"status", "views", "createdAt"}}
+	query, _ := url.ParseQuery("filter[views][gte]=30&filter[createdAt][lt]=2020-01-06&filter[status][ne]=draft,archived")
+
+	result, err := FromHTTPQuery(data, query, options)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Article{data[2]}, result.Data)
+	assert.Equal(t, 1, result.Total)
+}
+
+func TestHTTPQueryFilterManyValues(t *testing.T) {
+	type Article struct {
+		Title  string `json:"title"`
+		Status string `json:"status"`
+	}
+
+	data := []Article{{"echo", "archived"}, {"alpha", "active"}, {"bravo", "draft"}}
+	options := HTTPQueryOptions{SortableFields: []string{"title"}, FilterableFields: []string{"status"}}
+	query, _ := url.ParseQuery("filter[status]=draft,archived&sort=title")
+
+	result, err := FromHTTPQuery(data, query, options)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []Article{{"bravo", "draft"}, {"echo", "archived"}}, result.Data)
+}
+
+func TestHTTPQueryDefaults(t *testing.T) {
+	data := []map[string]int{{"views": 10}, {"views": 50}, {"views": 30}, {"views": 5}, {"views": 70}}
+	options := HTTPQueryOptions{DefaultSort: "-views", SortableFields: []string{"views"}, MaxPerPage: 3}
+
+	result, err := FromHTTPQuery(data, url.Values{"per_page": {"1000"}}, options)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]int{{"views": 70}, {"views": 50}, {"views": 30}}, result.Data)
+	assert.Equal(t, 3, result.PerPage)
+	assert.Equal(t, 5, result.Total)
+}
+
+func TestHTTPQueryPageOutOfRange(t *testing.T) {
+	data := []string{"a", "b", "c", "d", "e", "f"}
+	query, _ := url.ParseQuery("page=10&per_page=2")
+
+	result, err := FromHTTPQuery(data, query, HTTPQueryOptions{})
+
+	assert.Nil(t, err)
+	assert.Len(t, result.Data, 0)
+	assert.Equal(t, 6, result.Total)
+	assert.Equal(t, 3, result.TotalPages)
+}
+
+func TestHTTPQueryPageOverflow(t *testing.T) {
+	data := []string{"a", "b", "c"}
+	query := url.Values{"page": {strconv.FormatInt(math.MaxInt64, 10)}, "per_page": {"100"}}
+
+	result, err := FromHTTPQuery(data, query, HTTPQueryOptions{})
+
+	assert.Nil(t, err)
+	assert.Len(t, result.Data, 0)
+	assert.Equal(t, 3, result.Total)
+	assert.Equal(t, int64(math.MaxInt64), int64(result.Page))
+}
+
+func TestHTTPQueryMapData(t *testing.T) {
+	data := []map[string]interface{}{
+		{"name": "a", "age": 30},
+		{"name": "b", "age": 20},
+		{"name": "c", "age": 40},
+	}
+	options := HTTPQueryOptions{SortableFields: []string{"age"}, FilterableFields: []string{"age"}}
+	query, _ := url.ParseQuery("sort=-age&filter[age][gt]=25")
+
+	result, err := FromHTTPQuery(data, query, options)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{{"name": "c", "age": 40}, {"name": "a", "age": 30}}, result.Data)
+}
+
+func TestHTTPQueryNotSortable(t *testing.T) {
+	query, _ := url.ParseQuery("sort=status")
+
+	_, err := ParseHTTPQuery(query, HTTPQueryOptions{SortableFields: []string{"title"}})
+
+	queryErr := new(HTTPQueryError)
+	assert.True(t, errors.As(err, &queryErr))
+	assert.Equal(t, "sort", queryErr.Param)
+	assert.EqualError(t, err, `invalid query parameter sort: field "status" is not sortable`)
+}
+
+func TestHTTPQueryNotFilterable(t *testing.T) {
+	query, _ := url.ParseQuery("filter[title]=alpha")
+
+	_, err := ParseHTTPQuery(query, HTTPQueryOptions{FilterableFields: []string{"status"}})
+
+	assert.EqualError(t, err, `invalid query parameter filter[title]: field "title" is not filterable`)
+}
+
+func TestHTTPQueryInvalidFilter(t *testing.T) {
+	options := HTTPQueryOptions{FilterableFields: []string{"views"}}
+
+	for raw, message := range map[string]string{
+		"filter[views][like]=1": `invalid query parameter filter[views][like]: unknown operator "like"`,
+		"filter[views=1":        "invalid query parameter filter[views: filter must be in format filter[field] or filter[field][operator]",
+		"page=-1":               "invalid query parameter page: must be positive number",
+		"per_page=abc":          "invalid query parameter per_page: must be positive number",
+	} {
+		query, _ := url.ParseQuery(raw)
+		_, err := ParseHTTPQuery(query, options)
+
+		assert.EqualError(t, err, message, raw)
+	}
+}
+
+func TestHTTPQueryUnknownFilterField(t *testing.T) {
+	type Article struct {
+		Title string `json:"title"`
+	}
+
+	query, _ := url.ParseQuery("filter[status]=active")
+
+	_, err := FromHTTPQuery([]Article{{"alpha"}}, query, HTTPQueryOptions{FilterableFields: []string{"status"}})
+
+	queryErr := new(HTTPQueryError)
+	assert.True(t, errors.As(err, &queryErr))
+	assert.Equal(t, "filter[status]", queryErr.Param)
+	assert.EqualError(t, err, `invalid query parameter filter[status]: field "status" not found in gubrak.Article`)
+}
+
+func TestHTTPQueryInvalidFilterValue(t *testing.T) {
+	type Article struct {
+		Views int `json:"views"`
+	}
+
+	query, _ := url.ParseQuery("filter[views][gt]=many")
+
+	_, err := FromHTTPQuery([]Article{{Views: 10}}, query, HTTPQueryOptions{FilterableFields: []string{"views"}})
+
+	assert.EqualError(t, err, `invalid query parameter filter[views]: "many" is not a valid integer`)
+}
+
+func TestHTTPQueryHandler(t *testing.T) {
+	type Article struct {
+		ID     int    `json:"id"`
+		Status string `json:"status"`
+		Views  int    `json:"views"`
+	}
+
+	data := []Article{
+		{ID: 1, Status: "active", Views: 10},
+		{ID: 2, Status: "draft", Views: 50},
+		{ID: 3, Status: "active", Views: 30},
+		{ID: 4, Status: "active", Views: 20},
+		{ID: 5, Status: "active", Views: 70},
+	}
+	options := HTTPQueryOptions{
+		SortableFields:   []string{"views"},
+		FilterableFields: []string{"status"},
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result, err := FromHTTPQuery(data, r.URL.Query(), options)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		json.NewEncoder(w).Encode(result)
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/articles?sort=-views&filter[status]=active&per_page=2", nil))
+
+	response := struct {
+		Data       []Article `json:"data"`
+		Total      int       `json:"total"`
+		Count      int       `json:"count"`
+		Page       int       `json:"page"`
+		PerPage    int       `json:"per_page"`
+		TotalPages int       `json:"total_pages"`
+	}{}
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Nil(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.EqualValues(t, []Article{data[4], data[2]}, response.Data)
+	assert.Equal(t, 4, response.Total)
+	assert.Equal(t, 2, response.Count)
+	assert.Equal(t, 1, response.Page)
+	assert.Equal(t, 2, response.TotalPages)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/articles?sort=status", nil))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
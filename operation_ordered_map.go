@@ -0,0 +1,62 @@
+package gubrak
+
+// OrderedMap is map which keeps the insertion order of its keys. It is returned by `GroupByOrdered()`, `KeyByOrdered()` and `CountByOrdered()`.
+// Passing it to `From()` converts it into `[]KeyValue` in the same order, so it can be used as input to further chain operations.
+type OrderedMap struct {
+	keys   []interface{}
+	values map[interface{}]interface{}
+}
+
+// NewOrderedMap function creates empty ordered map
+func NewOrderedMap() *OrderedMap {
+	return &OrderedMap{
+		keys:   make([]interface{}, 0),
+		values: make(map[interface{}]interface{}),
+	}
+}
+
+// Set stores the value of the key. The position of existing key is not changed.
+func (m *OrderedMap) Set(key, value interface{}) {
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+
+	m.values[key] = value
+}
+
+// Get returns the value of the key, and `true` if the key exists
+func (m *OrderedMap) Get(key interface{}) (interface{}, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Keys returns the keys in insertion order
+func (m *OrderedMap) Keys() []interface{} {
+	keys := make([]interface{}, len(m.keys))
+	copy(keys, m.keys)
+	return keys
+}
+
+// Len returns the number of keys
+func (m *OrderedMap) Len() int {
+	return len(m.keys)
+}
+
+// Each invokes the callback for each key and value, in insertion order. Return `false` from the callback to stop the iteration.
+func (m *OrderedMap) Each(callback func(key, value interface{}) bool) {
+	for _, key := range m.keys {
+		if !callback(key, m.values[key]) {
+			return
+		}
+	}
+}
+
+// Pairs returns slice of `KeyValue`, in insertion order
+func (m *OrderedMap) Pairs() []KeyValue {
+	pairs := make([]KeyValue, 0, len(m.keys))
+	for _, key := range m.keys {
+		pairs = append(pairs, KeyValue{Key: key, Value: m.values[key]})
+	}
+
+	return pairs
+}
@@ -0,0 +1,65 @@
+package gubrak
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMapSetGet(t *testing.T) {
+	m := NewOrderedMap()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+
+	value, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+
+	_, ok = m.Get("c")
+	assert.False(t, ok)
+
+	assert.Equal(t, 2, m.Len())
+	assert.EqualValues(t, []interface{}{"b", "a"}, m.Keys())
+	assert.EqualValues(t, []KeyValue{{Key: "b", Value: 3}, {Key: "a", Value: 2}}, m.Pairs())
+}
+
+func TestOrderedMapEach(t *testing.T) {
+	m := NewOrderedMap()
+	m.Set(3, "c")
+	m.Set(1, "a")
+	m.Set(2, "b")
+
+	keys := make([]interface{}, 0)
+	m.Each(func(key, value interface{}) bool {
+		keys = append(keys, key)
+		return key != 1
+	})
+
+	assert.EqualValues(t, []interface{}{3, 1}, keys)
+}
+
+func TestOrderedMapKeysIsCopy(t *testing.T) {
+	m := NewOrderedMap()
+	m.Set("a", 1)
+
+	keys := m.Keys()
+	keys[0] = "z"
+
+	assert.EqualValues(t, []interface{}{"a"}, m.Keys())
+}
+
+func TestOrderedMapFrom(t *testing.T) {
+	m := NewOrderedMap()
+	m.Set("x", 10)
+	m.Set("y", 20)
+
+	result, err := From(m).
+		Map(func(each KeyValue) string {
+			return each.Key.(string)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"x", "y"}, result)
+}
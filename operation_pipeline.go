@@ -0,0 +1,817 @@
+package gubrak
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Pipeline is a declarative specification of chainable operations. Each step is applied, in order, to the result of the previous step.
+// A pipeline can be written as Go value, or parsed from JSON using `ParsePipeline()`, for example:
+//  [
+//    { "op": "Filter", "where": { "field": "tld", "eq": "com" } },
+//    { "op": "OrderBy", "by": "GlobalRank" },
+//    { "op": "Take", "n": 10 }
+//  ]
+type Pipeline []PipelineStep
+
+// PipelineStep represents a single operation of a pipeline. Only the fields relevant to the operation need to be filled.
+//  Op        string             // ==> description: name of the operation, e.g. "Filter", "OrderBy", "Take"
+//  Where     *PipelineCondition // ==> description: the predicate, used by Filter, Reject, Find, FindIndex, FindLast, FindLastIndex, CountBy, DropWhile, DropRightWhile, TakeWhile, TakeRightWhile
+//  By        string             // ==> description: the field path, used by OrderBy, GroupBy, KeyBy, Map
+//  Desc      bool               // ==> description: descending sort order, used by OrderBy
+//  N         *int               // ==> description: the size, required by Chunk, Drop, DropRight, SampleSize, Take, TakeRight
+//  Index     *int               // ==> description: the index, required by Nth, ExcludeAt
+//  Value     interface{}        // ==> description: the value, used by Contains, Exclude, Fill, IndexOf, LastIndexOf
+//  Values    []interface{}      // ==> description: the values, used by Concat, Difference, ExcludeMany, ExcludeAtMany, Intersection.
+//                               //     For ConcatMany, DifferenceMany, IntersectionMany, UnionMany each of the values is an array, e.g. [[1, 2], [3]]
+//  Separator string             // ==> description: the separator, used by Join
+//  Args      []int              // ==> description: the optional int arguments, e.g. start index of Find or start and end of Fill
+type PipelineStep struct {
+	Op        string             `json:"op"`
+	Where     *PipelineCondition `json:"where,omitempty"`
+	By        string             `json:"by,omitempty"`
+	Desc      bool               `json:"desc,omitempty"`
+	N         *int               `json:"n,omitempty"`
+	Index     *int               `json:"index,omitempty"`
+	Value     interface{}        `json:"value,omitempty"`
+	Values    []interface{}      `json:"values,omitempty"`
+	Separator string             `json:"separator,omitempty"`
+	Args      []int              `json:"args,omitempty"`
+}
+
+// PipelineCondition represents a comparison predicate against a field of each element.
+// Exactly one comparator must be set. The field is a dot separated path, e.g. "address.city",
+// each segment matched against struct field name, json tag, or map key.
+type PipelineCondition struct {
+	Field    string              `json:"field,omitempty"`
+	Eq       interface{}         `json:"eq,omitempty"`
+	Ne       interface{}         `json:"ne,omitempty"`
+	Gt       interface{}         `json:"gt,omitempty"`
+	Gte      interface{}         `json:"gte,omitempty"`
+	Lt       interface{}         `json:"lt,omitempty"`
+	Lte      interface{}         `json:"lte,omitempty"`
+	In       []interface{}       `json:"in,omitempty"`
+	Nin      []interface{}       `json:"nin,omitempty"`
+	Contains interface{}         `json:"contains,omitempty"`
+	And      []PipelineCondition `json:"and,omitempty"`
+	Or       []PipelineCondition `json:"or,omitempty"`
+	Not      *PipelineCondition  `json:"not,omitempty"`
+}
+
+// PipelineError is the error returned when a pipeline step is invalid or failed to be applied.
+type PipelineError struct {
+	Step int
+	Op   string
+	Err  error
+}
+
+func (e *PipelineError) Error() string {
+	return fmt.Sprintf("pipeline step %d (%s): %s", e.Step, e.Op, e.Err.Error())
+}
+
+// Unwrap returns the underlying error
+func (e *PipelineError) Unwrap() error {
+	return e.Err
+}
+
+// pipelineOperationArguments maps every supported operation to the step field it requires
+var pipelineOperationArguments = map[string]string{
+	"Chunk":         "n",
+	"Compact":       "",
+	"Concat":        "values",
+	"Contains":      "value",
+	"Count":         "",
+	"CountBy":       "where",
+	"Difference":    "values",
+	"Drop":          "n",
+	"DropRight":     "n",
+	"Exclude":       "value",
+	"ExcludeAt":     "index",
+	"ExcludeAtMany": "values",
+	"ExcludeMany":   "values",
+	"Fill":          "value",
+	"Filter":        "where",
+	"Find":          "where",
+	"FindIndex":     "where",
+	"FindLast":      "where",
+	"FindLastIndex": "where",
+	"First":         "",
+	"FromPairs":     "",
+	"GroupBy":       "by",
+	"IndexOf":       "value",
+	"Initial":       "",
+	"Intersection":  "values",
+	"Join":          "",
+	"KeyBy":         "by",
+	"Last":          "",
+	"LastIndexOf":   "value",
+	"Map":           "by",
+	"Nth":           "index",
+	"OrderBy":       "by",
+	"Reject":        "where",
+	"Reverse":       "",
+	"Sample":        "",
+	"SampleSize":    "n",
+	"Shuffle":       "",
+	"Size":          "",
+	"Tail":          "",
+	"Take":          "n",
+	"TakeRight":     "n",
+	"Uniq":          "",
+
+	// variadic operations, each of the values is an array
+	"ConcatMany":       "slices",
+	"DifferenceMany":   "slices",
+	"IntersectionMany": "slices",
+	"UnionMany":        "slices",
+
+	// predicate based slicing, stops at the first element the predicate returns falsey for
+	"DropRightWhile": "where",
+	"DropWhile":      "where",
+	"TakeRightWhile": "where",
+	"TakeWhile":      "where",
+}
+
+// PipelineOperations returns names of all operations supported by pipeline, sorted alphabetically.
+func PipelineOperations() []string {
+	result := make([]string, 0, len(pipelineOperationArguments))
+	for name := range pipelineOperationArguments {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// ParsePipeline function parses JSON pipeline specification, then validates it. The returned error is `*PipelineError` when one of the steps is invalid.
+func ParsePipeline(spec []byte) (Pipeline, error) {
+	rawSteps := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(spec, &rawSteps); err != nil {
+		return nil, fmt.Errorf("invalid pipeline specification: %s", err.Error())
+	}
+
+	pipeline := make(Pipeline, len(rawSteps))
+
+	for i, rawStep := range rawSteps {
+		decoder := json.NewDecoder(bytes.NewReader(rawStep))
+		decoder.UseNumber()
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&pipeline[i]); err != nil {
+			return nil, &PipelineError{Step: i, Op: pipeline[i].Op, Err: err}
+		}
+
+		pipeline[i].normalizeNumbers()
+	}
+
+	if err := pipeline.Validate(); err != nil {
+		return nil, err
+	}
+
+	return pipeline, nil
+}
+
+// Validate function checks every step of the pipeline, without applying it to any data. The returned error is `*PipelineError` pointing at the first invalid step.
+func (p Pipeline) Validate() error {
+	for i, step := range p {
+		if err := step.validate(); err != nil {
+			return &PipelineError{Step: i, Op: step.Op, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// Apply function runs the pipeline over `data`, by calling `From(data)` then each of the operations in order. On failure, the chain's error is `*PipelineError` pointing at the offending step.
+func (p Pipeline) Apply(data interface{}) IChainable {
+	g := From(data).(*Chainable)
+
+	for i, step := range p {
+		err := step.validate()
+		if err == nil {
+			err = step.applyTo(g)
+		}
+
+		if err != nil {
+			g.lastOperation = Operation(step.Op + "()")
+			return g.markError(nil, &PipelineError{Step: i, Op: step.Op, Err: err})
+		}
+
+		if g.IsError() {
+			g.lastErrorCaught = &PipelineError{Step: i, Op: step.Op, Err: g.lastErrorCaught}
+			return g
+		}
+	}
+
+	return g
+}
+
+func (s PipelineStep) validate() error {
+	if s.Op == "" {
+		return errors.New("op cannot be empty")
+	}
+
+	argument, ok := pipelineOperationArguments[s.Op]
+	if !ok {
+		return fmt.Errorf("unknown operation %q", s.Op)
+	}
+
+	switch argument {
+	case "where":
+		if s.Where == nil {
+			return errors.New("where cannot be empty")
+		}
+
+		return s.Where.validate()
+
+	case "by":
+		if s.By == "" {
+			return errors.New("by cannot be empty")
+		}
+
+	case "value":
+		if s.Value == nil {
+			return errors.New("value cannot be empty")
+		}
+
+	case "values":
+		if s.Values == nil {
+			return errors.New("values cannot be empty")
+		}
+
+	case "slices":
+		if len(s.Values) == 0 {
+			return errors.New("values cannot be empty")
+		}
+
+		for i, each := range s.Values {
+			if kind := reflect.ValueOf(each).Kind(); kind != reflect.Slice && kind != reflect.Array {
+				return fmt.Errorf("values[%d] should be an array", i)
+			}
+		}
+
+	case "n":
+		if s.N == nil {
+			return errors.New("n cannot be empty")
+		}
+
+	case "index":
+		if s.Index == nil {
+			return errors.New("index cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+func (s PipelineStep) applyTo(g *Chainable) error {
+	switch s.Op {
+	case "Chunk":
+		g.Chunk(*s.N)
+	case "Compact":
+		g.Compact()
+	case "Concat":
+		return _pipelineWithValues(g, s, func(values interface{}) { g.Concat(values) })
+	case "ConcatMany":
+		return _pipelineWithSlices(g, s, func(slices []interface{}) { g.ConcatMany(slices...) })
+	case "Contains":
+		return _pipelineWithValue(g, s, func(value interface{}) { g.Contains(value, s.Args...) })
+	case "Count":
+		g.Count()
+	case "CountBy":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.CountBy(predicate) })
+	case "Difference":
+		return _pipelineWithValues(g, s, func(values interface{}) { g.Difference(values) })
+	case "DifferenceMany":
+		return _pipelineWithSlices(g, s, func(slices []interface{}) { g.DifferenceMany(slices...) })
+	case "Drop":
+		g.Drop(*s.N)
+	case "DropRight":
+		g.DropRight(*s.N)
+	case "DropRightWhile":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.DropRightWhile(predicate) })
+	case "DropWhile":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.DropWhile(predicate) })
+	case "Exclude":
+		return _pipelineWithValue(g, s, func(value interface{}) { g.Exclude(value) })
+	case "ExcludeAt":
+		g.ExcludeAt(*s.Index)
+	case "ExcludeAtMany":
+		return _pipelineExcludeAtMany(g, s)
+	case "ExcludeMany":
+		return _pipelineExcludeMany(g, s)
+	case "Fill":
+		return _pipelineWithValue(g, s, func(value interface{}) { g.Fill(value, s.Args...) })
+	case "Filter":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.Filter(predicate) })
+	case "Find":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.Find(predicate, s.Args...) })
+	case "FindIndex":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.FindIndex(predicate, s.Args...) })
+	case "FindLast":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.FindLast(predicate, s.Args...) })
+	case "FindLastIndex":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.FindLastIndex(predicate, s.Args...) })
+	case "First":
+		g.First()
+	case "FromPairs":
+		g.FromPairs()
+	case "GroupBy":
+		return _pipelineWithKey(g, s, func(key interface{}) { g.GroupBy(key) })
+	case "IndexOf":
+		return _pipelineWithValue(g, s, func(value interface{}) { g.IndexOf(value, s.Args...) })
+	case "Initial":
+		g.Initial()
+	case "Intersection":
+		return _pipelineWithValues(g, s, func(values interface{}) { g.Intersection(values) })
+	case "IntersectionMany":
+		return _pipelineWithSlices(g, s, func(slices []interface{}) { g.IntersectionMany(slices...) })
+	case "Join":
+		g.Join(s.Separator)
+	case "KeyBy":
+		return _pipelineWithKey(g, s, func(key interface{}) { g.KeyBy(key) })
+	case "Last":
+		g.Last()
+	case "LastIndexOf":
+		return _pipelineWithValue(g, s, func(value interface{}) { g.LastIndexOf(value, s.Args...) })
+	case "Map":
+		return _pipelineWithKey(g, s, func(key interface{}) { g.Map(key) })
+	case "Nth":
+		g.Nth(*s.Index)
+	case "OrderBy":
+		return _pipelineWithKey(g, s, func(key interface{}) { g.OrderBy(key, !s.Desc) })
+	case "Reject":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.Reject(predicate) })
+	case "Reverse":
+		g.Reverse()
+	case "Sample":
+		g.Sample()
+	case "SampleSize":
+		g.SampleSize(*s.N)
+	case "Shuffle":
+		g.Shuffle()
+	case "Size":
+		g.Size()
+	case "Tail":
+		g.Tail()
+	case "Take":
+		g.Take(*s.N)
+	case "TakeRight":
+		g.TakeRight(*s.N)
+	case "TakeRightWhile":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.TakeRightWhile(predicate) })
+	case "TakeWhile":
+		return _pipelineWithPredicate(g, s, func(predicate interface{}) { g.TakeWhile(predicate) })
+	case "Uniq":
+		g.Uniq()
+	case "UnionMany":
+		return _pipelineWithSlices(g, s, func(slices []interface{}) { g.UnionMany(slices...) })
+	}
+
+	return nil
+}
+
+func (s *PipelineStep) normalizeNumbers() {
+	s.Value = normalizeJSONNumber(s.Value)
+	for i := range s.Values {
+		s.Values[i] = normalizeJSONNumber(s.Values[i])
+	}
+
+	if s.Where != nil {
+		s.Where.normalizeNumbers()
+	}
+}
+
+func (c *PipelineCondition) normalizeNumbers() {
+	for _, each := range []*interface{}{&c.Eq, &c.Ne, &c.Gt, &c.Gte, &c.Lt, &c.Lte, &c.Contains} {
+		*each = normalizeJSONNumber(*each)
+	}
+
+	for i := range c.In {
+		c.In[i] = normalizeJSONNumber(c.In[i])
+	}
+
+	for i := range c.Nin {
+		c.Nin[i] = normalizeJSONNumber(c.Nin[i])
+	}
+
+	for i := range c.And {
+		c.And[i].normalizeNumbers()
+	}
+
+	for i := range c.Or {
+		c.Or[i].normalizeNumbers()
+	}
+
+	if c.Not != nil {
+		c.Not.normalizeNumbers()
+	}
+}
+
+func normalizeJSONNumber(value interface{}) interface{} {
+	if values, ok := value.([]interface{}); ok {
+		for i := range values {
+			values[i] = normalizeJSONNumber(values[i])
+		}
+
+		return values
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+
+	if res, err := number.Int64(); err == nil {
+		return res
+	}
+
+	res, _ := number.Float64()
+	return res
+}
+
+func (c PipelineCondition) comparators() []string {
+	result := make([]string, 0)
+
+	for name, isSet := range map[string]bool{
+		"eq":       c.Eq != nil,
+		"ne":       c.Ne != nil,
+		"gt":       c.Gt != nil,
+		"gte":      c.Gte != nil,
+		"lt":       c.Lt != nil,
+		"lte":      c.Lte != nil,
+		"in":       c.In != nil,
+		"nin":      c.Nin != nil,
+		"contains": c.Contains != nil,
+		"and":      c.And != nil,
+		"or":       c.Or != nil,
+		"not":      c.Not != nil,
+	} {
+		if isSet {
+			result = append(result, name)
+		}
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+func (c PipelineCondition) validate() error {
+	comparators := c.comparators()
+
+	if len(comparators) == 0 {
+		return errors.New("where must have one comparator")
+	} else if len(comparators) > 1 {
+		return fmt.Errorf("where must only have one comparator, found %s", strings.Join(comparators, ", "))
+	}
+
+	switch comparators[0] {
+	case "and", "or", "not":
+		if c.Field != "" {
+			return fmt.Errorf("field cannot be used together with %s", comparators[0])
+		}
+
+		children := append(append([]PipelineCondition{}, c.And...), c.Or...)
+		if c.Not != nil {
+			children = append(children, *c.Not)
+		}
+
+		for _, each := range children {
+			if err := each.validate(); err != nil {
+				return err
+			}
+		}
+
+	default:
+		if c.Field == "" {
+			return errors.New("where field cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+func (c PipelineCondition) validateFields(elemType reflect.Type) error {
+	if c.Field != "" {
+		if _, err := fieldTypeByPath(elemType, c.Field); err != nil {
+			return err
+		}
+	}
+
+	children := append(append([]PipelineCondition{}, c.And...), c.Or...)
+	if c.Not != nil {
+		children = append(children, *c.Not)
+	}
+
+	for _, each := range children {
+		if err := each.validateFields(elemType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c PipelineCondition) match(each reflect.Value) bool {
+	switch {
+	case c.And != nil:
+		for _, condition := range c.And {
+			if !condition.match(each) {
+				return false
+			}
+		}
+
+		return true
+
+	case c.Or != nil:
+		for _, condition := range c.Or {
+			if condition.match(each) {
+				return true
+			}
+		}
+
+		return false
+
+	case c.Not != nil:
+		return !c.Not.match(each)
+	}
+
+	fieldValue, err := fieldValueByPath(each, c.Field)
+	if err != nil {
+		return false
+	}
+
+	compare := func(target interface{}, isExpected func(int) bool) bool {
+		res, ok := compareValues(fieldValue, reflect.ValueOf(target))
+		return ok && isExpected(res)
+	}
+
+	isIn := func(targets []interface{}) bool {
+		for _, target := range targets {
+			if isValueEqual(fieldValue, reflect.ValueOf(target)) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	switch {
+	case c.Eq != nil:
+		return isValueEqual(fieldValue, reflect.ValueOf(c.Eq))
+	case c.Ne != nil:
+		return !isValueEqual(fieldValue, reflect.ValueOf(c.Ne))
+	case c.Gt != nil:
+		return compare(c.Gt, func(res int) bool { return res > 0 })
+	case c.Gte != nil:
+		return compare(c.Gte, func(res int) bool { return res >= 0 })
+	case c.Lt != nil:
+		return compare(c.Lt, func(res int) bool { return res < 0 })
+	case c.Lte != nil:
+		return compare(c.Lte, func(res int) bool { return res <= 0 })
+	case c.In != nil:
+		return isIn(c.In)
+	case c.Nin != nil:
+		return !isIn(c.Nin)
+	case c.Contains != nil:
+		return _pipelineContains(fieldValue, c.Contains)
+	}
+
+	return false
+}
+
+func _pipelineContains(fieldValue reflect.Value, search interface{}) bool {
+	fieldValue = indirectValue(fieldValue)
+	if !fieldValue.IsValid() {
+		return false
+	}
+
+	switch fieldValue.Kind() {
+	case reflect.String:
+		searchString, ok := search.(string)
+		return ok && strings.Contains(fieldValue.String(), searchString)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < fieldValue.Len(); i++ {
+			if isValueEqual(fieldValue.Index(i), reflect.ValueOf(search)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func _pipelineElemType(data interface{}) (reflect.Type, error) {
+	if data == nil {
+		return nil, errors.New("data cannot be nil")
+	}
+
+	dataType := reflect.TypeOf(data)
+	if dataType.Kind() == reflect.Ptr {
+		dataType = dataType.Elem()
+	}
+
+	switch dataType.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return dataType.Elem(), nil
+	}
+
+	return nil, errors.New("data must be slice")
+}
+
+func _pipelineWithPredicate(g *Chainable, step PipelineStep, callOperation func(interface{})) error {
+	predicate, err := makeFieldPredicate(g.data, *step.Where)
+	if err != nil {
+		return err
+	}
+
+	callOperation(predicate)
+	return nil
+}
+
+func _pipelineWithKey(g *Chainable, step PipelineStep, callOperation func(interface{})) error {
+	keyFunc, err := makeFieldKeyFunc(g.data, step.By)
+	if err != nil {
+		return err
+	}
+
+	callOperation(keyFunc)
+	return nil
+}
+
+// makeFieldPredicate creates `func(each <element type>) bool` callback that matches each element of `data` against the condition
+func makeFieldPredicate(data interface{}, condition PipelineCondition) (interface{}, error) {
+	elemType, err := _pipelineElemType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := condition.validateFields(elemType); err != nil {
+		return nil, err
+	}
+
+	funcType := reflect.FuncOf([]reflect.Type{elemType}, []reflect.Type{reflect.TypeOf(true)}, false)
+	predicate := reflect.MakeFunc(funcType, func(args []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(condition.match(args[0]))}
+	})
+
+	return predicate.Interface(), nil
+}
+
+// makeFieldKeyFunc creates `func(each <element type>) <field type>` callback that returns the field of each element of `data`
+func makeFieldKeyFunc(data interface{}, path string) (interface{}, error) {
+	elemType, err := _pipelineElemType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	keyType, err := fieldTypeByPath(elemType, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// for dynamic values, e.g. map[string]interface{}, the key type is taken from the first non-nil value
+	if keyType.Kind() == reflect.Interface {
+		dataValue, _, _, dataValueLen := inspectData(data)
+		if dataValue.Kind() == reflect.Slice || dataValue.Kind() == reflect.Array {
+			forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+				fieldValue, _ := fieldValueByPath(each, path)
+				if fieldValue = indirectValue(fieldValue); fieldValue.IsValid() {
+					keyType = fieldValue.Type()
+					return false
+				}
+
+				return true
+			})
+		}
+	}
+
+	funcType := reflect.FuncOf([]reflect.Type{elemType}, []reflect.Type{keyType}, false)
+	keyFunc := reflect.MakeFunc(funcType, func(args []reflect.Value) []reflect.Value {
+		fieldValue, _ := fieldValueByPath(args[0], path)
+		if keyType.Kind() != reflect.Interface {
+			fieldValue = indirectValue(fieldValue)
+		}
+
+		if !fieldValue.IsValid() || !fieldValue.Type().AssignableTo(keyType) {
+			return []reflect.Value{reflect.Zero(keyType)}
+		}
+
+		return []reflect.Value{fieldValue}
+	})
+
+	return keyFunc.Interface(), nil
+}
+
+func _pipelineWithValue(g *Chainable, step PipelineStep, callOperation func(interface{})) error {
+	elemType, err := _pipelineElemType(g.data)
+	if err != nil {
+		return err
+	}
+
+	value, err := convertValueToType(step.Value, elemType)
+	if err != nil {
+		return err
+	}
+
+	callOperation(value.Interface())
+	return nil
+}
+
+func _pipelineWithValues(g *Chainable, step PipelineStep, callOperation func(interface{})) error {
+	elemType, err := _pipelineElemType(g.data)
+	if err != nil {
+		return err
+	}
+
+	values, err := _pipelineTypedSlice(elemType, step.Values, "values")
+	if err != nil {
+		return err
+	}
+
+	callOperation(values)
+	return nil
+}
+
+func _pipelineWithSlices(g *Chainable, step PipelineStep, callOperation func([]interface{})) error {
+	elemType, err := _pipelineElemType(g.data)
+	if err != nil {
+		return err
+	}
+
+	slices := make([]interface{}, 0, len(step.Values))
+	for i, each := range step.Values {
+		eachValue := reflect.ValueOf(each)
+
+		items := make([]interface{}, eachValue.Len())
+		for j := range items {
+			items[j] = eachValue.Index(j).Interface()
+		}
+
+		values, err := _pipelineTypedSlice(elemType, items, fmt.Sprintf("values[%d]", i))
+		if err != nil {
+			return err
+		}
+
+		slices = append(slices, values)
+	}
+
+	callOperation(slices)
+	return nil
+}
+
+// _pipelineTypedSlice converts each of `items` into `elemType`, then returns them as slice of `elemType`
+func _pipelineTypedSlice(elemType reflect.Type, items []interface{}, label string) (interface{}, error) {
+	values := reflect.MakeSlice(reflect.SliceOf(elemType), 0, len(items))
+	for i, each := range items {
+		value, err := convertValueToType(each, elemType)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %s", label, i, err.Error())
+		}
+
+		values = reflect.Append(values, value)
+	}
+
+	return values.Interface(), nil
+}
+
+func _pipelineExcludeMany(g *Chainable, step PipelineStep) error {
+	elemType, err := _pipelineElemType(g.data)
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, 0, len(step.Values))
+	for i, each := range step.Values {
+		value, err := convertValueToType(each, elemType)
+		if err != nil {
+			return fmt.Errorf("values[%d]: %s", i, err.Error())
+		}
+
+		values = append(values, value.Interface())
+	}
+
+	g.ExcludeMany(values...)
+	return nil
+}
+
+func _pipelineExcludeAtMany(g *Chainable, step PipelineStep) error {
+	indexes := make([]int, 0, len(step.Values))
+	for i, each := range step.Values {
+		value, err := convertValueToType(each, reflect.TypeOf(0))
+		if err != nil {
+			return fmt.Errorf("values[%d]: %s", i, err.Error())
+		}
+
+		indexes = append(indexes, int(value.Int()))
+	}
+
+	g.ExcludeAtMany(indexes...)
+	return nil
+}
@@ -0,0 +1,56 @@
+package gubrak
+
+import (
+	"fmt"
+)
+
+func ExampleParsePipeline() {
+	type Sample struct {
+		Domain     string
+		TLD        string
+		GlobalRank int
+	}
+
+	data := []Sample{
+		{Domain: "google.com", TLD: "com", GlobalRank: 1},
+		{Domain: "wikipedia.org", TLD: "org", GlobalRank: 7},
+		{Domain: "youtube.com", TLD: "com", GlobalRank: 2},
+	}
+
+	pipeline, err := ParsePipeline([]byte(`[
+		{ "op": "Filter", "where": { "field": "tld", "eq": "com" } },
+		{ "op": "OrderBy", "by": "GlobalRank", "desc": true },
+		{ "op": "Take", "n": 10 }
+	]`))
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	result := pipeline.Apply(data).Result()
+	fmt.Println(result)
+	// ===> []Sample{ { "youtube.com", "com", 2 }, { "google.com", "com", 1 } }
+}
+
+func ExamplePipeline_Apply() {
+	data := []map[string]interface{}{
+		{"name": "Jason", "age": 30},
+		{"name": "Ethan", "age": 17},
+		{"name": "Grace", "age": 25},
+	}
+
+	pipeline := Pipeline{
+		{Op: "Filter", Where: &PipelineCondition{Field: "age", Gte: 18}},
+		{Op: "Map", By: "name"},
+		{Op: "Join", Separator: ", "},
+	}
+
+	result, err := pipeline.Apply(data).ResultAndError()
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	fmt.Println(result)
+	// ===> "Jason, Grace"
+}
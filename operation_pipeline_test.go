@@ -0,0 +1,339 @@
+package gubrak
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePipelineFilterOrderByTake(t *testing.T) {
+	type Site struct {
+		Domain     string `json:"domain"`
+		TLD        string `json:"tld"`
+		GlobalRank int    `json:"globalRank"`
+	}
+
+	data := []Site{
+		{Domain: "google.com", TLD: "com", GlobalRank: 1},
+		{Domain: "wikipedia.org", TLD: "org", GlobalRank: 7},
+		{Domain: "kompas.id", TLD: "id", GlobalRank: 90},
+		{Domain: "youtube.com", TLD: "com", GlobalRank: 2},
+		{Domain: "detik.com", TLD: "com", GlobalRank: 50},
+	}
+
+	pipeline, err := ParsePipeline([]byte(`[
+		{"op":"Filter","where":{"field":"tld","eq":"com"}},
+		{"op":"OrderBy","by":"GlobalRank","desc":true},
+		{"op":"Take","n":2}
+	]`))
+	assert.Nil(t, err)
+
+	result, err := pipeline.Apply(data).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"detik.com", "youtube.com"}, []string{
+		result.([]Site)[0].Domain,
+		result.([]Site)[1].Domain,
+	})
+}
+
+func TestPipelineFieldPath(t *testing.T) {
+	type Owner struct {
+		Country string `json:"country"`
+	}
+
+	type Site struct {
+		Domain string `json:"domain"`
+		Owner  Owner  `json:"owner"`
+	}
+
+	data := []Site{
+		{Domain: "google.com"},
+		{Domain: "kompas.id", Owner: Owner{Country: "ID"}},
+		{Domain: "youtube.com"},
+		{Domain: "detik.com", Owner: Owner{Country: "ID"}},
+	}
+
+	pipeline := Pipeline{
+		{Op: "Filter", Where: &PipelineCondition{Field: "owner.country", Eq: "ID"}},
+		{Op: "Map", By: "Domain"},
+	}
+
+	result, err := pipeline.Apply(data).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"kompas.id", "detik.com"}, result)
+}
+
+func TestPipelineComparators(t *testing.T) {
+	type Site struct {
+		Domain     string `json:"domain"`
+		TLD        string `json:"tld"`
+		GlobalRank int    `json:"globalRank"`
+	}
+
+	data := []Site{
+		{Domain: "google.com", TLD: "com", GlobalRank: 1},
+		{Domain: "wikipedia.org", TLD: "org", GlobalRank: 7},
+		{Domain: "kompas.id", TLD: "id", GlobalRank: 90},
+		{Domain: "youtube.com", TLD: "com", GlobalRank: 2},
+		{Domain: "detik.com", TLD: "com", GlobalRank: 50},
+	}
+
+	pipeline, err := ParsePipeline([]byte(`[
+		{"op":"Filter","where":{"and":[
+			{"field":"globalRank","gte":2},
+			{"field":"globalRank","lt":90},
+			{"not":{"field":"tld","in":["org"]}}
+		]}},
+		{"op":"Map","by":"domain"}
+	]`))
+	assert.Nil(t, err)
+
+	result, err := pipeline.Apply(data).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"youtube.com", "detik.com"}, result)
+}
+
+func TestPipelineContainsAndOr(t *testing.T) {
+	type Site struct {
+		Domain     string `json:"domain"`
+		TLD        string `json:"tld"`
+		GlobalRank int    `json:"globalRank"`
+	}
+
+	data := []Site{
+		{Domain: "google.com", TLD: "com", GlobalRank: 1},
+		{Domain: "wikipedia.org", TLD: "org", GlobalRank: 7},
+		{Domain: "kompas.id", TLD: "id", GlobalRank: 90},
+		{Domain: "youtube.com", TLD: "com", GlobalRank: 2},
+		{Domain: "detik.com", TLD: "com", GlobalRank: 50},
+	}
+
+	pipeline := Pipeline{
+		{Op: "Reject", Where: &PipelineCondition{Or: []PipelineCondition{
+			{Field: "domain", Contains: "tube"},
+			{Field: "tld", Ne: "com"},
+		}}},
+		{Op: "Count"},
+	}
+
+	result, err := pipeline.Apply(data).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result)
+}
+
+func TestPipelineNonCallbackOperations(t *testing.T) {
+	pipeline, err := ParsePipeline([]byte(`[
+		{"op":"Concat","values":[3,4,5]},
+		{"op":"Exclude","value":4},
+		{"op":"Reverse"},
+		{"op":"Drop","n":1},
+		{"op":"Join","separator":","}
+	]`))
+	assert.Nil(t, err)
+
+	result, err := pipeline.Apply([]int{1, 2}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "3,2,1", result)
+}
+
+func TestPipelineTakeWhileDropWhile(t *testing.T) {
+	type Site struct {
+		Domain     string `json:"domain"`
+		TLD        string `json:"tld"`
+		GlobalRank int    `json:"globalRank"`
+	}
+
+	data := []Site{
+		{Domain: "google.com", TLD: "com", GlobalRank: 1},
+		{Domain: "wikipedia.org", TLD: "org", GlobalRank: 7},
+		{Domain: "kompas.id", TLD: "id", GlobalRank: 90},
+		{Domain: "youtube.com", TLD: "com", GlobalRank: 2},
+		{Domain: "detik.com", TLD: "com", GlobalRank: 50},
+	}
+
+	pipeline, err := ParsePipeline([]byte(`[
+		{"op":"OrderBy","by":"globalRank"},
+		{"op":"TakeWhile","where":{"field":"globalRank","lt":60}},
+		{"op":"DropWhile","where":{"field":"tld","eq":"com"}},
+		{"op":"Map","by":"domain"}
+	]`))
+	assert.Nil(t, err)
+
+	result, err := pipeline.Apply(data).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"wikipedia.org", "detik.com"}, result)
+}
+
+func TestPipelineMapData(t *testing.T) {
+	data := []map[string]interface{}{
+		{"name": "b", "age": 30},
+		{"name": "a", "age": 20},
+		{"name": "c", "age": 10},
+	}
+
+	pipeline, err := ParsePipeline([]byte(`[
+		{"op":"Filter","where":{"field":"age","gt":15}},
+		{"op":"OrderBy","by":"name"},
+		{"op":"Map","by":"name"}
+	]`))
+	assert.Nil(t, err)
+
+	result, err := pipeline.Apply(data).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"a", "b"}, result)
+}
+
+func TestParsePipelineInvalidJSON(t *testing.T) {
+	_, err := ParsePipeline([]byte(`{"op":"Take"}`))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid pipeline specification")
+}
+
+func TestParsePipelineUnknownOperation(t *testing.T) {
+	_, err := ParsePipeline([]byte(`[{"op":"Take","n":1},{"op":"Explode"}]`))
+
+	pipelineErr := new(PipelineError)
+	assert.True(t, errors.As(err, &pipelineErr))
+	assert.Equal(t, 1, pipelineErr.Step)
+	assert.EqualError(t, err, `pipeline step 1 (Explode): unknown operation "Explode"`)
+}
+
+func TestParsePipelineUnknownField(t *testing.T) {
+	_, err := ParsePipeline([]byte(`[{"op":"Take","count":1}]`))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "pipeline step 0 (Take)")
+}
+
+func TestParsePipelineMissingArgument(t *testing.T) {
+	_, err := ParsePipeline([]byte(`[{"op":"Take","n":1},{"op":"OrderBy"}]`))
+
+	assert.EqualError(t, err, "pipeline step 1 (OrderBy): by cannot be empty")
+}
+
+func TestParsePipelineMissingSize(t *testing.T) {
+	for _, op := range []string{"Chunk", "Drop", "DropRight", "SampleSize", "Take", "TakeRight"} {
+		_, err := ParsePipeline([]byte(`[{"op":"` + op + `"}]`))
+
+		assert.EqualError(t, err, "pipeline step 0 ("+op+"): n cannot be empty")
+	}
+}
+
+func TestParsePipelineMissingIndex(t *testing.T) {
+	for _, op := range []string{"ExcludeAt", "Nth"} {
+		_, err := ParsePipeline([]byte(`[{"op":"` + op + `"}]`))
+
+		assert.EqualError(t, err, "pipeline step 0 ("+op+"): index cannot be empty")
+	}
+}
+
+func TestParsePipelineZeroIndex(t *testing.T) {
+	pipeline, err := ParsePipeline([]byte(`[{"op":"Nth","index":0}]`))
+	assert.Nil(t, err)
+
+	result, err := pipeline.Apply([]string{"a", "b"}).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, "a", result)
+}
+
+func TestPipelineVariadicOperations(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+
+	for spec, expected := range map[string][]int{
+		`[{"op":"ConcatMany","values":[[5],[6,7]]}]`:           {1, 2, 3, 4, 5, 6, 7},
+		`[{"op":"DifferenceMany","values":[[1],[3,5]]}]`:       {2, 4},
+		`[{"op":"IntersectionMany","values":[[2,3,4],[3,4]]}]`: {3, 4},
+		`[{"op":"UnionMany","values":[[4,5],[6]]}]`:            {1, 2, 3, 4, 5, 6},
+	} {
+		pipeline, err := ParsePipeline([]byte(spec))
+		assert.Nil(t, err)
+
+		result, err := pipeline.Apply(data).ResultAndError()
+
+		assert.Nil(t, err, spec)
+		assert.EqualValues(t, expected, result, spec)
+	}
+}
+
+func TestParsePipelineVariadicOperationNotArray(t *testing.T) {
+	_, err := ParsePipeline([]byte(`[{"op":"ConcatMany","values":[[5],6]}]`))
+
+	assert.EqualError(t, err, "pipeline step 0 (ConcatMany): values[1] should be an array")
+}
+
+func TestPipelineVariadicOperationTypeMismatch(t *testing.T) {
+	pipeline := Pipeline{
+		{Op: "UnionMany", Values: []interface{}{[]interface{}{4}, []interface{}{"a"}}},
+	}
+
+	_, err := pipeline.Apply([]int{1, 2}).ResultAndError()
+
+	assert.EqualError(t, err, "pipeline step 0 (UnionMany): values[1][0]: cannot use a (string) as int")
+}
+
+func TestParsePipelineMultipleComparators(t *testing.T) {
+	_, err := ParsePipeline([]byte(`[{"op":"Filter","where":{"field":"tld","eq":"com","ne":"org"}}]`))
+
+	assert.EqualError(t, err, "pipeline step 0 (Filter): where must only have one comparator, found eq, ne")
+}
+
+func TestPipelineUnknownFieldPath(t *testing.T) {
+	type Owner struct {
+		Country string `json:"country"`
+	}
+
+	type Site struct {
+		Domain string `json:"domain"`
+		Owner  Owner  `json:"owner"`
+	}
+
+	data := []Site{
+		{Domain: "google.com"},
+		{Domain: "kompas.id", Owner: Owner{Country: "ID"}},
+		{Domain: "youtube.com"},
+		{Domain: "detik.com", Owner: Owner{Country: "ID"}},
+	}
+
+	size := 3
+	pipeline := Pipeline{
+		{Op: "Take", N: &size},
+		{Op: "OrderBy", By: "owner.city"},
+	}
+
+	result, err := pipeline.Apply(data).ResultAndError()
+
+	assert.Nil(t, result)
+	assert.EqualError(t, err, `pipeline step 1 (OrderBy): field "city" not found in gubrak.Owner`)
+}
+
+func TestPipelineOperationError(t *testing.T) {
+	size := -1
+	pipeline := Pipeline{
+		{Op: "Take", N: &size},
+	}
+
+	chain := pipeline.Apply([]int{1, 2, 3})
+
+	assert.EqualError(t, chain.Error(), "pipeline step 0 (Take): size must not be negative number")
+	assert.Equal(t, Operation(OperationTake), chain.LastErrorOperation())
+}
+
+func TestPipelineValueTypeMismatch(t *testing.T) {
+	pipeline := Pipeline{
+		{Op: "Exclude", Value: "a"},
+	}
+
+	_, err := pipeline.Apply([]int{1, 2}).ResultAndError()
+
+	assert.EqualError(t, err, "pipeline step 0 (Exclude): cannot use a (string) as int")
+}
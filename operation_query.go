@@ -0,0 +1,491 @@
+package gubrak
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrQueryUnknownColumn is returned (wrapped in `*QueryColumnError`) when a column does not exist in the data
+	ErrQueryUnknownColumn = errors.New("unknown column")
+
+	// ErrQueryInvalidColumn is returned (wrapped in `*QueryColumnError`) when a column expression cannot be parsed
+	ErrQueryInvalidColumn = errors.New("invalid column")
+
+	// ErrQueryUngroupedColumn is returned (wrapped in `*QueryColumnError`) when a selected column is neither grouped nor aggregated
+	ErrQueryUngroupedColumn = errors.New("column must appear in GroupBy or be used in aggregate function")
+)
+
+// QueryColumnError is the error returned by query builder when a column is invalid. Use `errors.Is()` to check the cause,
+// e.g. `errors.Is(err, ErrQueryUnknownColumn)`.
+type QueryColumnError struct {
+	Clause string
+	Column string
+	Err    error
+}
+
+func (e *QueryColumnError) Error() string {
+	return fmt.Sprintf("%s: %s %q", e.Clause, e.Err.Error(), e.Column)
+}
+
+// Unwrap returns the underlying error
+func (e *QueryColumnError) Unwrap() error {
+	return e.Err
+}
+
+// QueryBuilder is SQL-like query builder over in-memory slice. Each element is projected into `map[string]interface{}` row.
+// The clauses are executed in this order, regardless of the order they are called:
+//  Where -> GroupBy -> Select -> Having -> OrderBy -> Offset -> Limit
+type QueryBuilder struct {
+	data    interface{}
+	where   []interface{}
+	columns []string
+	groupBy []string
+	having  []interface{}
+	orderBy []HTTPQuerySort
+	limit   int
+	offset  int
+
+	// the query is executed once, the rows and error are kept until one of the clauses is changed
+	isExecuted bool
+	rows       []map[string]interface{}
+	err        error
+}
+
+type queryColumn struct {
+	name      string
+	aggregate string
+	field     string
+}
+
+var queryColumnPattern = regexp.MustCompile(`^(?i:(count|sum|avg|min|max))\(\s*([^()\s]+)\s*\)$`)
+
+// Query function is the initial function to use query builder.
+//  Query(orders).
+//      Where(Expr("Status == 'paid'")).
+//      Select("Customer", "count(*) as orders", "sum(Amount) as total").
+//      GroupBy("Customer").
+//      Having(Expr("total > 100")).
+//      OrderBy("total", false).
+//      Limit(10)
+func Query(data interface{}) *QueryBuilder {
+	return &QueryBuilder{data: data, limit: -1}
+}
+
+// Where adds predicate to filter the data, before grouping. The predicate is `func(each anyType) bool`, or `*Expression`. Multiple predicates are combined with AND.
+func (q *QueryBuilder) Where(predicate interface{}) *QueryBuilder {
+	q.where = append(q.where, predicate)
+	q.isExecuted = false
+	return q
+}
+
+// Select sets the columns of each row. Column is a field path, or aggregate function: `count(*)`, `count(field)`, `sum(field)`, `avg(field)`, `min(field)`, `max(field)`.
+// Use `as` to rename the column, e.g. `sum(Amount) as total`. When no column is selected, all exported fields of each element are selected, keyed by the struct field name.
+func (q *QueryBuilder) Select(columns ...string) *QueryBuilder {
+	q.columns = append(q.columns, columns...)
+	q.isExecuted = false
+	return q
+}
+
+// GroupBy groups the data by one or more field paths. Groups are ordered by their first appearance.
+func (q *QueryBuilder) GroupBy(fields ...string) *QueryBuilder {
+	q.groupBy = append(q.groupBy, fields...)
+	q.isExecuted = false
+	return q
+}
+
+// Having adds predicate to filter the rows, after grouping. The predicate is `func(row map[string]interface{}) bool`, or `*Expression`.
+func (q *QueryBuilder) Having(predicate interface{}) *QueryBuilder {
+	q.having = append(q.having, predicate)
+	q.isExecuted = false
+	return q
+}
+
+// OrderBy sorts the rows by a selected column. Call it multiple times to sort by multiple columns, the first call is the most significant.
+func (q *QueryBuilder) OrderBy(column string, isAscending ...bool) *QueryBuilder {
+	sortKey := HTTPQuerySort{Field: column}
+	if len(isAscending) > 0 {
+		sortKey.IsDescending = !isAscending[0]
+	}
+
+	q.orderBy = append(q.orderBy, sortKey)
+	q.isExecuted = false
+	return q
+}
+
+// Limit sets the maximum number of rows returned
+func (q *QueryBuilder) Limit(limit int) *QueryBuilder {
+	q.limit = limit
+	q.isExecuted = false
+	return q
+}
+
+// Offset sets the number of rows to skip
+func (q *QueryBuilder) Offset(offset int) *QueryBuilder {
+	q.offset = offset
+	q.isExecuted = false
+	return q
+}
+
+// Result returns the rows
+func (q *QueryBuilder) Result() []map[string]interface{} {
+	result, _ := q.ResultAndError()
+	return result
+}
+
+// Error returns the error object
+func (q *QueryBuilder) Error() error {
+	_, err := q.ResultAndError()
+	return err
+}
+
+// ResultAndError executes the query, then returns the rows and error object. The query is executed only once, subsequent calls return the same rows and error until one of the clauses is changed.
+func (q *QueryBuilder) ResultAndError() ([]map[string]interface{}, error) {
+	if !q.isExecuted {
+		q.rows, q.err = q.execute()
+		q.isExecuted = true
+	}
+
+	return q.rows, q.err
+}
+
+func (q *QueryBuilder) execute() ([]map[string]interface{}, error) {
+	elemType, err := _pipelineElemType(q.data)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := q.parseColumns(elemType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range q.groupBy {
+		if err := q.validateField("GroupBy", elemType, field); err != nil {
+			return nil, err
+		}
+	}
+
+	g := From(q.data).(*Chainable)
+	for _, predicate := range q.where {
+		if g.Filter(predicate); g.IsError() {
+			return nil, g.Error()
+		}
+	}
+
+	rows, err := q.project(g.data, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	g = From(rows).(*Chainable)
+	for _, predicate := range q.having {
+		if g.Filter(predicate); g.IsError() {
+			return nil, g.Error()
+		}
+	}
+
+	for _, sortKey := range q.orderBy {
+		isSelected := len(q.columns) == 0 && q.validateField("OrderBy", elemType, sortKey.Field) == nil
+		for _, column := range columns {
+			isSelected = isSelected || column.name == sortKey.Field
+		}
+
+		if !isSelected {
+			return nil, &QueryColumnError{Clause: "OrderBy", Column: sortKey.Field, Err: ErrQueryUnknownColumn}
+		}
+	}
+
+	if len(q.orderBy) > 0 {
+		g = From(_sortByFields(g.data, q.orderBy, func(each reflect.Value, column string) reflect.Value {
+			row := each.Interface().(map[string]interface{})
+			if value, ok := row[column]; ok {
+				return reflect.ValueOf(value)
+			}
+
+			// nested field path of unselected data, e.g. "Address.City"
+			value, _ := fieldValueByPath(each, column)
+			return value
+		})).(*Chainable)
+	}
+
+	if q.offset > 0 {
+		g.Drop(q.offset)
+	}
+
+	if q.limit >= 0 {
+		g.Take(q.limit)
+	}
+
+	if g.IsError() {
+		return nil, g.Error()
+	}
+
+	return g.data.([]map[string]interface{}), nil
+}
+
+func (q *QueryBuilder) validateField(clause string, elemType reflect.Type, field string) error {
+	if _, err := fieldTypeByPath(elemType, field); err != nil {
+		return &QueryColumnError{Clause: clause, Column: field, Err: ErrQueryUnknownColumn}
+	}
+
+	return nil
+}
+
+func (q *QueryBuilder) parseColumns(elemType reflect.Type) ([]queryColumn, error) {
+	columns := make([]queryColumn, 0, len(q.columns))
+
+	for _, each := range q.columns {
+		column := queryColumn{name: strings.TrimSpace(each)}
+		expression := column.name
+
+		if parts := strings.Fields(each); len(parts) == 3 && strings.EqualFold(parts[1], "as") {
+			expression, column.name = parts[0], parts[2]
+		} else if len(parts) != 1 && !queryColumnPattern.MatchString(expression) {
+			return nil, &QueryColumnError{Clause: "Select", Column: each, Err: ErrQueryInvalidColumn}
+		}
+
+		if matches := queryColumnPattern.FindStringSubmatch(expression); matches != nil {
+			column.aggregate = strings.ToLower(matches[1])
+			column.field = matches[2]
+
+			if column.field == "*" && column.aggregate != "count" {
+				return nil, &QueryColumnError{Clause: "Select", Column: each, Err: ErrQueryInvalidColumn}
+			}
+		} else if strings.ContainsAny(expression, "()* ") {
+			return nil, &QueryColumnError{Clause: "Select", Column: each, Err: ErrQueryInvalidColumn}
+		} else {
+			column.field = expression
+		}
+
+		if column.field != "*" {
+			if err := q.validateField("Select", elemType, column.field); err != nil {
+				return nil, err
+			}
+		}
+
+		columns = append(columns, column)
+	}
+
+	isAggregated := len(q.groupBy) > 0
+	for _, column := range columns {
+		isAggregated = isAggregated || column.aggregate != ""
+	}
+
+	if isAggregated {
+		if len(columns) == 0 {
+			return nil, &QueryColumnError{Clause: "Select", Column: "*", Err: ErrQueryUngroupedColumn}
+		}
+
+		for _, column := range columns {
+			if column.aggregate != "" {
+				continue
+			}
+
+			isGrouped := false
+			for _, field := range q.groupBy {
+				isGrouped = isGrouped || field == column.field
+			}
+
+			if !isGrouped {
+				return nil, &QueryColumnError{Clause: "Select", Column: column.field, Err: ErrQueryUngroupedColumn}
+			}
+		}
+	}
+
+	return columns, nil
+}
+
+func (q *QueryBuilder) project(data interface{}, columns []queryColumn) ([]map[string]interface{}, error) {
+	dataValue, _, _, dataValueLen := inspectData(data)
+	rows := make([]map[string]interface{}, 0)
+
+	isAggregated := len(q.groupBy) > 0
+	for _, column := range columns {
+		isAggregated = isAggregated || column.aggregate != ""
+	}
+
+	if !isAggregated {
+		var err error
+
+		forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+			row := make(map[string]interface{})
+
+			if len(columns) == 0 {
+				row = _queryRowOf(each)
+			}
+
+			for _, column := range columns {
+				value, errField := fieldValueByPath(each, column.field)
+				if errField != nil {
+					err = &QueryColumnError{Clause: "Select", Column: column.field, Err: ErrQueryUnknownColumn}
+					return false
+				}
+
+				row[column.name] = _queryInterface(value)
+			}
+
+			rows = append(rows, row)
+			return true
+		})
+
+		return rows, err
+	}
+
+	groups := []interface{}{data}
+	if len(q.groupBy) > 0 {
+		groupKeys := make([]string, 0)
+
+		funcType := reflect.FuncOf([]reflect.Type{dataValue.Type().Elem()}, []reflect.Type{reflect.TypeOf("")}, false)
+		keyFunc := reflect.MakeFunc(funcType, func(args []reflect.Value) []reflect.Value {
+			return []reflect.Value{reflect.ValueOf(q.groupKey(args[0]))}
+		})
+
+		grouped, err := From(data).GroupBy(keyFunc.Interface()).ResultAndError()
+		if err != nil {
+			return nil, err
+		}
+
+		// groups are ordered by their first appearance
+		seen := make(map[string]bool)
+		forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+			key := q.groupKey(each)
+			if !seen[key] {
+				seen[key] = true
+				groupKeys = append(groupKeys, key)
+			}
+		})
+
+		groupedValue := reflect.ValueOf(grouped)
+		groups = make([]interface{}, 0, len(groupKeys))
+		for _, key := range groupKeys {
+			groups = append(groups, groupedValue.MapIndex(reflect.ValueOf(key)).Interface())
+		}
+	}
+
+	for _, group := range groups {
+		groupValue, _, _, groupValueLen := inspectData(group)
+		row := make(map[string]interface{})
+
+		for _, column := range columns {
+			if column.aggregate == "" {
+				if groupValueLen > 0 {
+					value, _ := fieldValueByPath(groupValue.Index(0), column.field)
+					row[column.name] = _queryInterface(value)
+				}
+
+				continue
+			}
+
+			row[column.name] = _queryAggregate(column, groupValue, groupValueLen)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func (q *QueryBuilder) groupKey(each reflect.Value) string {
+	parts := make([]string, 0, len(q.groupBy))
+	for _, field := range q.groupBy {
+		value, _ := fieldValueByPath(each, field)
+		parts = append(parts, fmt.Sprintf("%#v", _queryInterface(indirectValue(value))))
+	}
+
+	return strings.Join(parts, "\x00")
+}
+
+func _queryAggregate(column queryColumn, groupValue reflect.Value, groupValueLen int) interface{} {
+	count := 0
+	sum := float64(0)
+	var extreme reflect.Value
+
+	forEachSlice(groupValue, groupValueLen, func(each reflect.Value, i int) {
+		if column.field == "*" {
+			count++
+			return
+		}
+
+		value, _ := fieldValueByPath(each, column.field)
+		if value = indirectValue(value); !value.IsValid() {
+			return
+		}
+
+		count++
+
+		if isNumberKind(value.Kind()) {
+			sum += numberToFloat(value)
+		}
+
+		if !extreme.IsValid() {
+			extreme = value
+			return
+		}
+
+		if res, ok := compareValues(value, extreme); ok {
+			if (column.aggregate == "min" && res < 0) || (column.aggregate == "max" && res > 0) {
+				extreme = value
+			}
+		}
+	})
+
+	switch column.aggregate {
+	case "count":
+		return count
+	case "sum":
+		return sum
+	case "avg":
+		if count == 0 {
+			return nil
+		}
+
+		return sum / float64(count)
+	}
+
+	if !extreme.IsValid() {
+		return nil
+	}
+
+	return extreme.Interface()
+}
+
+func _queryRowOf(each reflect.Value) map[string]interface{} {
+	row := make(map[string]interface{})
+	each = indirectValue(each)
+
+	switch each.Kind() {
+	case reflect.Struct:
+		for i := 0; i < each.NumField(); i++ {
+			field := each.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+
+			row[field.Name] = each.Field(i).Interface()
+		}
+
+	case reflect.Map:
+		for _, key := range each.MapKeys() {
+			row[fmt.Sprintf("%v", key.Interface())] = each.MapIndex(key).Interface()
+		}
+
+	default:
+		if each.IsValid() {
+			row["it"] = each.Interface()
+		}
+	}
+
+	return row
+}
+
+func _queryInterface(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	return value.Interface()
+}
@@ -0,0 +1,50 @@
+package gubrak
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleQuery() {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+	}
+
+	orders := []Order{
+		{Customer: "alice", Status: "paid", Amount: 120},
+		{Customer: "bob", Status: "paid", Amount: 30},
+		{Customer: "alice", Status: "cancelled", Amount: 500},
+		{Customer: "alice", Status: "paid", Amount: 15},
+	}
+
+	rows, err := Query(orders).
+		Where(Expr("Status == 'paid'")).
+		Select("Customer", "count(*) as orders", "sum(Amount) as total").
+		GroupBy("Customer").
+		Having(Expr("total > 100")).
+		OrderBy("total", false).
+		Limit(10).
+		ResultAndError()
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	fmt.Println(rows)
+	// ===> [map[Customer:alice orders:2 total:135]]
+}
+
+func ExampleQuery_unknownColumn() {
+	type Order struct {
+		Customer string
+		Amount   float64
+	}
+
+	err := Query([]Order{}).Select("sum(Price)").Error()
+	if errors.Is(err, ErrQueryUnknownColumn) {
+		fmt.Println(err.Error())
+		// ===> Select: unknown column "Price"
+	}
+}
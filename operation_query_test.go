@@ -0,0 +1,315 @@
+package gubrak
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuerySelectWhereOrderByLimit(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+		Quantity int
+	}
+
+	data := []Order{
+		{Customer: "alice", Status: "paid", Amount: 120, Quantity: 2},
+		{Customer: "bob", Status: "paid", Amount: 30, Quantity: 1},
+		{Customer: "alice", Status: "cancelled", Amount: 500, Quantity: 9},
+		{Customer: "carol", Status: "paid", Amount: 80, Quantity: 4},
+		{Customer: "bob", Status: "paid", Amount: 90, Quantity: 3},
+		{Customer: "alice", Status: "paid", Amount: 15, Quantity: 1},
+	}
+
+	result, err := Query(data).
+		Where(func(each Order) bool { return each.Status == "paid" }).
+		Select("Customer", "Amount as total").
+		OrderBy("total", false).
+		Offset(1).
+		Limit(2).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{
+		{"Customer": "bob", "total": float64(90)},
+		{"Customer": "carol", "total": float64(80)},
+	}, result)
+}
+
+func TestQueryGroupByAggregates(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+		Quantity int
+	}
+
+	data := []Order{
+		{Customer: "alice", Status: "paid", Amount: 120, Quantity: 2},
+		{Customer: "bob", Status: "paid", Amount: 30, Quantity: 1},
+		{Customer: "alice", Status: "cancelled", Amount: 500, Quantity: 9},
+		{Customer: "carol", Status: "paid", Amount: 80, Quantity: 4},
+		{Customer: "bob", Status: "paid", Amount: 90, Quantity: 3},
+		{Customer: "alice", Status: "paid", Amount: 15, Quantity: 1},
+	}
+
+	result, err := Query(data).
+		Where(Expr("Status == 'paid'")).
+		Select("Customer", "count(*) as orders", "sum(Amount) as total", "avg(Quantity)", "min(Amount)", "max(Quantity) as most").
+		GroupBy("Customer").
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{
+		{"Customer": "alice", "orders": 2, "total": float64(135), "avg(Quantity)": float64(1.5), "min(Amount)": float64(15), "most": 2},
+		{"Customer": "bob", "orders": 2, "total": float64(120), "avg(Quantity)": float64(2), "min(Amount)": float64(30), "most": 3},
+		{"Customer": "carol", "orders": 1, "total": float64(80), "avg(Quantity)": float64(4), "min(Amount)": float64(80), "most": 4},
+	}, result)
+}
+
+func TestQueryHavingOrderByMultipleColumns(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+		Quantity int
+	}
+
+	data := []Order{
+		{Customer: "alice", Status: "paid", Amount: 120, Quantity: 2},
+		{Customer: "bob", Status: "paid", Amount: 30, Quantity: 1},
+		{Customer: "alice", Status: "cancelled", Amount: 500, Quantity: 9},
+		{Customer: "carol", Status: "paid", Amount: 80, Quantity: 4},
+		{Customer: "bob", Status: "paid", Amount: 90, Quantity: 3},
+		{Customer: "alice", Status: "paid", Amount: 15, Quantity: 1},
+	}
+
+	result, err := Query(data).
+		Select("Status", "Customer", "count(*) as orders").
+		GroupBy("Status", "Customer").
+		Having(func(row map[string]interface{}) bool { return row["Status"] == "paid" }).
+		OrderBy("orders", false).
+		OrderBy("Customer").
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{
+		{"Status": "paid", "Customer": "alice", "orders": 2},
+		{"Status": "paid", "Customer": "bob", "orders": 2},
+		{"Status": "paid", "Customer": "carol", "orders": 1},
+	}, result)
+}
+
+func TestQueryAggregateWithoutGroupBy(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+		Quantity int
+	}
+
+	data := []Order{
+		{Customer: "alice", Status: "paid", Amount: 120, Quantity: 2},
+		{Customer: "bob", Status: "paid", Amount: 30, Quantity: 1},
+		{Customer: "alice", Status: "cancelled", Amount: 500, Quantity: 9},
+		{Customer: "carol", Status: "paid", Amount: 80, Quantity: 4},
+		{Customer: "bob", Status: "paid", Amount: 90, Quantity: 3},
+		{Customer: "alice", Status: "paid", Amount: 15, Quantity: 1},
+	}
+
+	result, err := Query(data).
+		Select("count(*) as orders", "sum(Quantity) as quantity").
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{{"orders": 6, "quantity": float64(20)}}, result)
+}
+
+func TestQuerySelectAll(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+		Quantity int
+	}
+
+	data := []Order{
+		{Customer: "alice", Status: "paid", Amount: 120, Quantity: 2},
+		{Customer: "bob", Status: "paid", Amount: 30, Quantity: 1},
+		{Customer: "alice", Status: "cancelled", Amount: 500, Quantity: 9},
+		{Customer: "carol", Status: "paid", Amount: 80, Quantity: 4},
+		{Customer: "bob", Status: "paid", Amount: 90, Quantity: 3},
+		{Customer: "alice", Status: "paid", Amount: 15, Quantity: 1},
+	}
+
+	result, err := Query(data).
+		Where(Expr("Amount > 100")).
+		OrderBy("Amount").
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{
+		{"Customer": "alice", "Status": "paid", "Amount": float64(120), "Quantity": 2},
+		{"Customer": "alice", "Status": "cancelled", "Amount": float64(500), "Quantity": 9},
+	}, result)
+}
+
+func TestQueryMapData(t *testing.T) {
+	data := []map[string]interface{}{
+		{"name": "b", "age": 30},
+		{"name": "a", "age": 20},
+		{"name": "c", "age": 10},
+	}
+
+	result, err := Query(data).
+		Where(Expr("age > 15")).
+		Select("name").
+		OrderBy("name").
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{{"name": "a"}, {"name": "b"}}, result)
+}
+
+func TestQueryUnknownColumn(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+	}
+
+	data := []Order{{Customer: "alice", Status: "paid", Amount: 120}}
+
+	err := Query(data).Select("Customer", "sum(Price)").GroupBy("Customer").Error()
+
+	columnErr := new(QueryColumnError)
+	assert.True(t, errors.As(err, &columnErr))
+	assert.True(t, errors.Is(err, ErrQueryUnknownColumn))
+	assert.Equal(t, "Price", columnErr.Column)
+	assert.EqualError(t, err, `Select: unknown column "Price"`)
+}
+
+func TestQueryUnknownOrderByColumn(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+	}
+
+	data := []Order{{Customer: "alice", Status: "paid", Amount: 120}}
+
+	err := Query(data).Select("Customer").OrderBy("Amount").Error()
+
+	assert.True(t, errors.Is(err, ErrQueryUnknownColumn))
+	assert.EqualError(t, err, `OrderBy: unknown column "Amount"`)
+}
+
+func TestQueryUnknownGroupByColumn(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+	}
+
+	data := []Order{{Customer: "alice", Status: "paid", Amount: 120}}
+
+	err := Query(data).Select("count(*)").GroupBy("Region").Error()
+
+	assert.EqualError(t, err, `GroupBy: unknown column "Region"`)
+}
+
+func TestQueryUngroupedColumn(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+	}
+
+	data := []Order{{Customer: "alice", Status: "paid", Amount: 120}}
+
+	err := Query(data).Select("Customer", "Status", "count(*)").GroupBy("Customer").Error()
+
+	assert.True(t, errors.Is(err, ErrQueryUngroupedColumn))
+	assert.EqualError(t, err, `Select: column must appear in GroupBy or be used in aggregate function "Status"`)
+}
+
+func TestQueryInvalidColumn(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+	}
+
+	data := []Order{{Customer: "alice", Status: "paid", Amount: 120}}
+
+	err := Query(data).Select("sum(*)").Error()
+
+	assert.True(t, errors.Is(err, ErrQueryInvalidColumn))
+}
+
+func TestQueryWhereError(t *testing.T) {
+	type Order struct {
+		Customer string
+		Status   string
+		Amount   float64
+	}
+
+	data := []Order{{Customer: "alice", Status: "paid", Amount: 120}}
+
+	err := Query(data).Where(func(each int) bool { return true }).Error()
+
+	assert.NotNil(t, err)
+}
+
+func TestQueryExecutedOnce(t *testing.T) {
+	data := []int{1, 2, 3}
+
+	calls := 0
+	query := Query(data).Where(func(each int) bool {
+		calls++
+		return each > 1
+	})
+
+	rows := query.Result()
+	err := query.Error()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{{"it": 2}, {"it": 3}}, rows)
+	assert.Equal(t, 3, calls)
+
+	rows = query.Limit(1).Result()
+
+	assert.EqualValues(t, []map[string]interface{}{{"it": 2}}, rows)
+	assert.Equal(t, 6, calls)
+}
+
+func TestQueryOrderByNestedField(t *testing.T) {
+	type Address struct {
+		City string
+	}
+
+	type Customer struct {
+		Name string
+		Addr Address
+	}
+
+	data := []Customer{
+		{Name: "alice", Addr: Address{City: "Surabaya"}},
+		{Name: "bob", Addr: Address{City: "Jakarta"}},
+		{Name: "carol", Addr: Address{City: "Malang"}},
+	}
+
+	result, err := Query(data).
+		OrderBy("Addr.City").
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []map[string]interface{}{
+		{"Name": "bob", "Addr": Address{City: "Jakarta"}},
+		{"Name": "carol", "Addr": Address{City: "Malang"}},
+		{"Name": "alice", "Addr": Address{City: "Surabaya"}},
+	}, result)
+}
@@ -0,0 +1,372 @@
+package gubrak
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// _sortKey returns function to get the sort key of each element, using the optional iteratee in `args`.
+// The iteratee is the same as the one used by `OrderBy()`, `func(each anyType)<any type>` or `*Expression`. If it's not provided, the element itself is the key.
+func _sortKey(err *error, dataValue reflect.Value, args []interface{}) func(reflect.Value) reflect.Value {
+	if len(args) == 0 || args[0] == nil {
+		return func(each reflect.Value) reflect.Value {
+			return each
+		}
+	}
+
+	callback := bindExpression(err, args[0], dataValue, false)
+	if *err != nil {
+		return nil
+	}
+
+	callbackValue, callbackType := inspectFunc(err, callback)
+	if *err != nil {
+		return nil
+	}
+
+	validateFuncInputForSliceLoopWithoutIndex(err, callbackType, dataValue)
+	if *err != nil {
+		return nil
+	}
+
+	validateFuncOutputOneVarDynamic(err, callbackType)
+	if *err != nil {
+		return nil
+	}
+
+	return func(each reflect.Value) reflect.Value {
+		return callbackValue.Call([]reflect.Value{each})[0]
+	}
+}
+
+// _compareKeys compares two sort keys, it returns negative number if `left` is lower, zero if both are equal, or positive number if `left` is greater
+func _compareKeys(err *error, left, right reflect.Value) int {
+	res, ok := compareValues(left, right)
+	if !ok && *err == nil {
+		*err = fmt.Errorf("cannot compare %v with %v", left.Interface(), right.Interface())
+	}
+
+	return res
+}
+
+// _sortedKeys gets the sort key of each element of `dataValue`, and makes sure they are in ascending order
+func _sortedKeys(err *error, label string, dataValue reflect.Value, keyOf func(reflect.Value) reflect.Value) []reflect.Value {
+	dataValueLen := dataValue.Len()
+
+	keys := make([]reflect.Value, 0, dataValueLen)
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		keys = append(keys, keyOf(each))
+		if i > 0 && _compareKeys(err, keys[i-1], keys[i]) > 0 && *err == nil {
+			*err = fmt.Errorf("%s should be sorted in ascending order", label)
+		}
+
+		return *err == nil
+	})
+
+	return keys
+}
+
+// _sortedOther inspects the slice to compare with the sorted `dataValue`, both should have the same element type
+func _sortedOther(err *error, label string, dataValue reflect.Value, other interface{}) reflect.Value {
+	if !isNonNilData(err, label, other) {
+		return reflect.Value{}
+	}
+
+	otherValue, _, _, _ := inspectData(other)
+
+	if !isSlice(err, label, otherValue) {
+		return reflect.Value{}
+	}
+
+	if otherValue.Type().Elem() != dataValue.Type().Elem() {
+		*err = errors.New("data type of each elements between slice must be same")
+		return reflect.Value{}
+	}
+
+	return otherValue
+}
+
+// _sortedSearch gets the index at which `value` should be inserted into sorted `data` to keep it sorted.
+// The lowest index is returned if `isLast` is false, otherwise the highest one.
+func _sortedSearch(err *error, data, value interface{}, args []interface{}, isLast bool) (int, bool) {
+	if !isNonNilData(err, "data", data) {
+		return -1, false
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return -1, false
+	}
+
+	keyOf := _sortKey(err, dataValue, args)
+	if *err != nil {
+		return -1, false
+	}
+
+	valueValue := reflect.ValueOf(value)
+	if len(args) > 0 && args[0] != nil {
+		if value == nil || !valueValue.Type().AssignableTo(dataValue.Type().Elem()) {
+			*err = errors.New("value data type should be same with data element type")
+			return -1, false
+		}
+	}
+
+	key := keyOf(valueValue)
+
+	index := sort.Search(dataValueLen, func(i int) bool {
+		res := _compareKeys(err, keyOf(dataValue.Index(i)), key)
+		if isLast {
+			return res > 0
+		}
+
+		return res >= 0
+	})
+	if *err != nil {
+		return -1, false
+	}
+
+	isFound := index < dataValueLen && _compareKeys(err, keyOf(dataValue.Index(index)), key) == 0
+	return index, isFound
+}
+
+func _sortedUniq(err *error, data interface{}, args []interface{}) interface{} {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, dataType, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	keyOf := _sortKey(err, dataValue, args)
+	if *err != nil {
+		return nil
+	}
+
+	keys := _sortedKeys(err, "data", dataValue, keyOf)
+	if *err != nil {
+		return nil
+	}
+
+	result := makeSlice(dataType)
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		if i == 0 || _compareKeys(err, keys[i-1], keys[i]) != 0 {
+			result = reflect.Append(result, each)
+		}
+	})
+
+	return result.Interface()
+}
+
+// _sortedSetOperation walks sorted `data` and sorted `other` together. Elements of `data` which key exists in `other` are kept if `isIntersection` is true,
+// in that case each key is only kept once. Otherwise, elements of `data` which key does not exist in `other` are kept.
+func _sortedSetOperation(err *error, data, other interface{}, args []interface{}, isIntersection bool) interface{} {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, dataType, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	otherValue := _sortedOther(err, "data to compare", dataValue, other)
+	if *err != nil {
+		return nil
+	}
+
+	keyOf := _sortKey(err, dataValue, args)
+	if *err != nil {
+		return nil
+	}
+
+	keys := _sortedKeys(err, "data", dataValue, keyOf)
+	if *err != nil {
+		return nil
+	}
+
+	otherKeys := _sortedKeys(err, "data to compare", otherValue, keyOf)
+	if *err != nil {
+		return nil
+	}
+
+	result := makeSlice(dataType)
+	j := 0
+	for i := 0; i < dataValueLen && *err == nil; i++ {
+		for j < len(otherKeys) && _compareKeys(err, otherKeys[j], keys[i]) < 0 {
+			j++
+		}
+
+		isFound := j < len(otherKeys) && _compareKeys(err, otherKeys[j], keys[i]) == 0
+		if isIntersection {
+			isDuplicate := i > 0 && _compareKeys(err, keys[i-1], keys[i]) == 0
+			if isFound && !isDuplicate {
+				result = reflect.Append(result, dataValue.Index(i))
+			}
+		} else if !isFound {
+			result = reflect.Append(result, dataValue.Index(i))
+		}
+	}
+	if *err != nil {
+		return nil
+	}
+
+	return result.Interface()
+}
+
+// _mergeSorted merges sorted `data` and the other sorted slices using k-way merge.
+// The iteratee can be passed as the last element of `others`. Elements with the same key are ordered by their slice, `data` first.
+func _mergeSorted(err *error, data interface{}, others []interface{}) interface{} {
+	args := []interface{}{}
+	if len(others) > 0 {
+		last := others[len(others)-1]
+		if _, ok := last.(*Expression); ok || reflect.TypeOf(last) != nil && reflect.TypeOf(last).Kind() == reflect.Func {
+			args = append(args, last)
+			others = others[:len(others)-1]
+		}
+	}
+
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, dataType, _, _ := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	keyOf := _sortKey(err, dataValue, args)
+	if *err != nil {
+		return nil
+	}
+
+	slices := []reflect.Value{dataValue}
+	for i, other := range others {
+		otherValue := _sortedOther(err, fmt.Sprintf("merge data %d", i+1), dataValue, other)
+		if *err != nil {
+			return nil
+		}
+
+		slices = append(slices, otherValue)
+	}
+
+	cursors := &mergeSortedHeap{err: err}
+	length := 0
+	for i, slice := range slices {
+		label := "data"
+		if i > 0 {
+			label = fmt.Sprintf("merge data %d", i)
+		}
+
+		keys := _sortedKeys(err, label, slice, keyOf)
+		if *err != nil {
+			return nil
+		}
+
+		length += len(keys)
+		if len(keys) > 0 {
+			cursors.cursors = append(cursors.cursors, mergeSortedCursor{slice: slice, keys: keys, source: i})
+		}
+	}
+
+	heap.Init(cursors)
+
+	result := makeSlice(dataType, 0, length)
+	for cursors.Len() > 0 && *err == nil {
+		cursor := &cursors.cursors[0]
+		result = reflect.Append(result, cursor.slice.Index(cursor.position))
+
+		cursor.position++
+		if cursor.position < len(cursor.keys) {
+			heap.Fix(cursors, 0)
+		} else {
+			heap.Pop(cursors)
+		}
+	}
+	if *err != nil {
+		return nil
+	}
+
+	return result.Interface()
+}
+
+type mergeSortedCursor struct {
+	slice    reflect.Value
+	keys     []reflect.Value
+	source   int
+	position int
+}
+
+// mergeSortedHeap is min heap of the next element of each slice to merge
+type mergeSortedHeap struct {
+	cursors []mergeSortedCursor
+	err     *error
+}
+
+func (h *mergeSortedHeap) Len() int {
+	return len(h.cursors)
+}
+
+func (h *mergeSortedHeap) Less(i, j int) bool {
+	left, right := h.cursors[i], h.cursors[j]
+
+	res := _compareKeys(h.err, left.keys[left.position], right.keys[right.position])
+	if res == 0 {
+		return left.source < right.source
+	}
+
+	return res < 0
+}
+
+func (h *mergeSortedHeap) Swap(i, j int) {
+	h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i]
+}
+
+func (h *mergeSortedHeap) Push(x interface{}) {
+	h.cursors = append(h.cursors, x.(mergeSortedCursor))
+}
+
+func (h *mergeSortedHeap) Pop() interface{} {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
+
+func _isSorted(err *error, data interface{}, args []interface{}) bool {
+	if !isNonNilData(err, "data", data) {
+		return false
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return false
+	}
+
+	keyOf := _sortKey(err, dataValue, args)
+	if *err != nil {
+		return false
+	}
+
+	isSorted := true
+	previous := reflect.Value{}
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		key := keyOf(each)
+		if i > 0 && _compareKeys(err, previous, key) > 0 {
+			isSorted = false
+		}
+
+		previous = key
+		return isSorted && *err == nil
+	})
+
+	return isSorted
+}
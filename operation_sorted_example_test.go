@@ -0,0 +1,47 @@
+package gubrak
+
+import (
+	"fmt"
+)
+
+func ExampleChainable_SortedIndex_sortedIndex1() {
+	data := []int{10, 20, 20, 30}
+
+	index := From(data).SortedIndex(20).Result()
+	lastIndex := From(data).SortedLastIndex(20).Result()
+
+	fmt.Println(index, lastIndex)
+	// ===> 1 3
+}
+
+func ExampleChainable_SortedIntersection_sortedIntersection1() {
+	result := From([]int{1, 2, 2, 3, 5, 8}).
+		SortedIntersection([]int{2, 3, 4, 8}).
+		Result()
+
+	fmt.Println(result)
+	// ===> []int{ 2, 3, 8 }
+}
+
+func ExampleChainable_MergeSorted_mergeSorted1() {
+	type Event struct {
+		Name string
+		At   int
+	}
+
+	result := From([]Event{{"deploy", 1}, {"rollback", 5}}).
+		MergeSorted([]Event{{"alert", 3}}, []Event{{"ack", 4}}, Expr("At")).
+		Result()
+
+	fmt.Println(result)
+	// ===> []Event{ {"deploy", 1}, {"alert", 3}, {"ack", 4}, {"rollback", 5} }
+}
+
+func ExampleChainable_IsSorted_isSorted1() {
+	result := From([]string{"damian", "grayson", "tim"}).
+		IsSorted().
+		Result()
+
+	fmt.Println(result)
+	// ===> true
+}
@@ -0,0 +1,290 @@
+package gubrak
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedIndex(t *testing.T) {
+	data := []int{10, 20, 20, 20, 30}
+
+	result, err := From(data).SortedIndex(20).ResultAndError()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result)
+
+	result, err = From(data).SortedLastIndex(20).ResultAndError()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result)
+
+	assert.Equal(t, 0, From(data).SortedIndex(5).Result())
+	assert.Equal(t, 5, From(data).SortedIndex(35).Result())
+	assert.Equal(t, 4, From(data).SortedIndex(25.5).Result())
+	assert.Equal(t, 0, From([]int{}).SortedIndex(1).Result())
+}
+
+func TestSortedIndexWithIteratee(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := []User{
+		{Name: "damian", Age: 13},
+		{Name: "tim", Age: 17},
+		{Name: "jason", Age: 17},
+		{Name: "grayson", Age: 21},
+	}
+
+	result, err := From(users).
+		SortedIndex(User{Age: 17}, func(each User) int {
+			return each.Age
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result)
+
+	result, err = From(users).
+		SortedLastIndex(User{Age: 17}, Expr("Age")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result)
+}
+
+func TestSortedIndexInvalidValue(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := []User{
+		{Name: "damian", Age: 13},
+		{Name: "tim", Age: 17},
+		{Name: "jason", Age: 17},
+		{Name: "grayson", Age: 21},
+	}
+
+	_, err := From(users).
+		SortedIndex(17, func(each User) int {
+			return each.Age
+		}).
+		ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "value data type should be same with data element type")
+}
+
+func TestSortedIndexIncomparable(t *testing.T) {
+	result, err := From([]int{1, 2, 3}).SortedIndex([]int{2}).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "cannot compare 2 with [2]")
+	assert.Equal(t, -1, result)
+}
+
+func TestSortedIndexOf(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := []User{
+		{Name: "damian", Age: 13},
+		{Name: "tim", Age: 17},
+		{Name: "jason", Age: 17},
+		{Name: "grayson", Age: 21},
+	}
+
+	data := []string{"a", "b", "b", "d"}
+
+	assert.Equal(t, 1, From(data).SortedIndexOf("b").Result())
+	assert.Equal(t, -1, From(data).SortedIndexOf("c").Result())
+	assert.Equal(t, -1, From(data).SortedIndexOf("e").Result())
+
+	result, err := From(users).
+		SortedIndexOf(User{Age: 21}, Expr("Age")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result)
+}
+
+func TestSortedUniq(t *testing.T) {
+	result, err := From([]int{1, 1, 2, 3, 3, 3, 4}).SortedUniq().ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 2, 3, 4}, result)
+}
+
+func TestSortedUniqWithIteratee(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := []User{
+		{Name: "damian", Age: 13},
+		{Name: "tim", Age: 17},
+		{Name: "jason", Age: 17},
+		{Name: "grayson", Age: 21},
+	}
+
+	result, err := From(users).
+		SortedUniq(func(each User) int {
+			return each.Age
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []User{
+		{Name: "damian", Age: 13},
+		{Name: "tim", Age: 17},
+		{Name: "grayson", Age: 21},
+	}, result)
+}
+
+func TestSortedUniqUnsortedData(t *testing.T) {
+	_, err := From([]int{1, 3, 2}).SortedUniq().ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "data should be sorted in ascending order")
+}
+
+func TestSortedIntersection(t *testing.T) {
+	result, err := From([]int{1, 2, 2, 3, 5, 8}).
+		SortedIntersection([]int{2, 3, 3, 4, 8, 9}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{2, 3, 8}, result)
+}
+
+func TestSortedIntersectionWithIteratee(t *testing.T) {
+	result, err := From([]string{"Apple", "banana", "Cherry"}).
+		SortedIntersection([]string{"BANANA", "cherry", "durian"}, func(each string) string {
+			return strings.ToLower(each)
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"banana", "Cherry"}, result)
+}
+
+func TestSortedIntersectionUnsortedData(t *testing.T) {
+	_, err := From([]int{1, 2}).SortedIntersection([]int{3, 1}).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "data to compare should be sorted in ascending order")
+}
+
+func TestSortedIntersectionDifferentDataType(t *testing.T) {
+	_, err := From([]int{1, 2}).SortedIntersection([]string{"1"}).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "data type of each elements between slice must be same")
+}
+
+func TestSortedDifference(t *testing.T) {
+	result, err := From([]int{1, 2, 2, 3, 5, 8}).
+		SortedDifference([]int{2, 4, 8}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 3, 5}, result)
+
+	result, err = From([]int{1, 1, 3}).SortedDifference([]int{}).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{1, 1, 3}, result)
+}
+
+func TestMergeSorted(t *testing.T) {
+	result, err := From([]int{1, 4, 7}).
+		MergeSorted([]int{2, 5, 8}, []int{}, []int{0, 3, 6, 9}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, result)
+}
+
+func TestMergeSortedIsStable(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := []User{
+		{Name: "damian", Age: 13},
+		{Name: "tim", Age: 17},
+		{Name: "jason", Age: 17},
+		{Name: "grayson", Age: 21},
+	}
+
+	others := []User{
+		{Name: "cassandra", Age: 13},
+		{Name: "stephanie", Age: 17},
+	}
+
+	result, err := From(users).
+		MergeSorted(others, func(each User) int {
+			return each.Age
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []string{"damian", "cassandra", "tim", "jason", "stephanie", "grayson"}, From(result).Map(func(each User) string {
+		return each.Name
+	}).Result())
+}
+
+func TestMergeSortedWithExpression(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	result, err := From([]User{{Name: "b", Age: 2}}).
+		MergeSorted([]User{{Name: "a", Age: 1}}, Expr("Age")).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []User{{Name: "a", Age: 1}, {Name: "b", Age: 2}}, result)
+}
+
+func TestMergeSortedUnsortedData(t *testing.T) {
+	_, err := From([]int{1, 2}).MergeSorted([]int{1, 2}, []int{5, 3}).ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "merge data 2 should be sorted in ascending order")
+}
+
+func TestIsSorted(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := []User{
+		{Name: "damian", Age: 13},
+		{Name: "tim", Age: 17},
+		{Name: "jason", Age: 17},
+		{Name: "grayson", Age: 21},
+	}
+
+	assert.True(t, From([]int{1, 2, 2, 3}).IsSorted().Result())
+	assert.False(t, From([]int{1, 3, 2}).IsSorted().Result())
+	assert.True(t, From([]int{}).IsSorted().Result())
+	assert.True(t, From(users).IsSorted(Expr("Age")).Result())
+	assert.False(t, From(users).IsSorted(func(each User) string {
+		return each.Name
+	}).Result())
+}
+
+func TestIsSortedIncomparable(t *testing.T) {
+	_, err := From([]interface{}{1, "a"}).IsSorted().ResultAndError()
+
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "cannot compare 1 with a")
+}
@@ -0,0 +1,30 @@
+package gubrak
+
+import (
+	"reflect"
+)
+
+// _statsNumbers converts numeric `data`, or the iteratee result of each element, into float numbers
+func _statsNumbers(err *error, data interface{}, args []interface{}) []float64 {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	numberOf := elementIteratee(err, "element", dataValue.Type().Elem(), args, true)
+	if *err != nil {
+		return nil
+	}
+
+	numbers := make([]float64, 0, dataValueLen)
+	forEachSlice(dataValue, dataValueLen, func(each reflect.Value, i int) {
+		numbers = append(numbers, numberToFloat(indirectValue(numberOf(each))))
+	})
+
+	return numbers
+}
@@ -0,0 +1,39 @@
+package gubrak
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/novalagung/gubrak/v2/stats"
+)
+
+func ExampleChainable_Percentile_percentile1() {
+	latencies := []int{12, 15, 11, 250, 14, 13, 16, 18, 12, 900}
+
+	p50 := From(latencies).Percentile(50, stats.PercentileLinear).Result()
+	p90 := From(latencies).Percentile(90, stats.PercentileNearest).Result()
+	fmt.Println(p50, p90)
+	// ===> 14.5 250
+}
+
+func ExampleChainable_HistogramEdges_histogramEdges1() {
+	type Request struct {
+		Path      string
+		LatencyMs int
+	}
+
+	data := []Request{{"/a", 12}, {"/b", 250}, {"/a", 14}, {"/c", 900}}
+
+	result := From(data).
+		HistogramEdges([]float64{0, 100, 500, math.Inf(1)}, func(each Request) int {
+			return each.LatencyMs
+		}).
+		Result()
+
+	for _, bin := range result.([]stats.HistogramBin) {
+		fmt.Println(bin.Lower, bin.Upper, bin.Count)
+	}
+	// ===> 0 100 2
+	// ===> 100 500 1
+	// ===> 500 +Inf 1
+}
@@ -0,0 +1,177 @@
+package gubrak
+
+import (
+	"math"
+	"testing"
+
+	"github.com/novalagung/gubrak/v2/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedian(t *testing.T) {
+	result, err := From([]int{5, 1, 3}).Median().ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, result)
+
+	result, err = From([]int{5, 1, 3, 2}).Median().ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2.5, result)
+}
+
+func TestMedianWithIteratee(t *testing.T) {
+	type Request struct {
+		Path      string
+		LatencyMs int
+	}
+
+	data := []Request{{"/a", 120}, {"/b", 80}, {"/a", 200}, {"/c", 40}}
+
+	result, err := From(data).
+		Median(func(each Request) int {
+			return each.LatencyMs
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 100, result)
+}
+
+func TestMedianEmptyData(t *testing.T) {
+	_, err := From([]float64{}).Median().ResultAndError()
+	assert.EqualError(t, err, "data cannot be empty")
+}
+
+func TestMedianNaN(t *testing.T) {
+	_, err := From([]float64{1, math.NaN()}).Median().ResultAndError()
+	assert.EqualError(t, err, "data should not contain NaN")
+}
+
+func TestPercentile(t *testing.T) {
+	result, err := From([]uint8{40, 10, 30, 20}).Percentile(50, stats.PercentileLower).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 20, result)
+
+	_, err = From([]uint8{3, 1, 2}).Percentile(101, stats.PercentileLinear).ResultAndError()
+	assert.EqualError(t, err, "percentile should be between 0 and 100")
+}
+
+func TestQuantile(t *testing.T) {
+	result, err := From([]float64{1, 2, 3, 4, 5}).Quantile(0.9, stats.PercentileLinear).ResultAndError()
+	assert.Nil(t, err)
+	assert.InDelta(t, 4.6, result, 1e-9)
+
+	_, err = From([]int{1}).Quantile(-0.1, stats.PercentileLinear).ResultAndError()
+	assert.EqualError(t, err, "quantile should be between 0 and 1")
+}
+
+func TestVarianceStdDev(t *testing.T) {
+	data := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	result, err := From(data).Variance().ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 4, result)
+
+	result, err = From(data).StdDev().ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2, result)
+
+	result, err = From(data).SampleVariance().ResultAndError()
+	assert.Nil(t, err)
+	assert.InDelta(t, 32.0/7, result, 1e-12)
+
+	result, err = From(data).SampleStdDev().ResultAndError()
+	assert.Nil(t, err)
+	assert.InDelta(t, math.Sqrt(32.0/7), result, 1e-12)
+}
+
+func TestSampleVarianceSingleElement(t *testing.T) {
+	_, err := From([]int{1}).SampleVariance().ResultAndError()
+	assert.EqualError(t, err, "data should have at least 2 elements")
+}
+
+func TestCovarianceCorrelation(t *testing.T) {
+	type Request struct {
+		Path      string
+		LatencyMs int
+		SizeKb    float64
+	}
+
+	data := []Request{
+		{Path: "/a", LatencyMs: 120, SizeKb: 12},
+		{Path: "/b", LatencyMs: 80, SizeKb: 8},
+		{Path: "/a", LatencyMs: 200, SizeKb: 20},
+		{Path: "/c", LatencyMs: 40, SizeKb: 4},
+	}
+
+	latency := func(each Request) int {
+		return each.LatencyMs
+	}
+	size := func(each Request) float64 {
+		return each.SizeKb
+	}
+
+	result, err := From(data).Covariance(latency, size).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 350, result)
+
+	result, err = From(data).SampleCovariance(latency, size).ResultAndError()
+	assert.Nil(t, err)
+	assert.InDelta(t, 1400.0/3, result, 1e-9)
+
+	result, err = From(data).Correlation(latency, size).ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, result)
+}
+
+func TestZScores(t *testing.T) {
+	result, err := From([]int{2, 4, 4, 4, 5, 5, 7, 9}).ZScores().ResultAndError()
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}, result)
+
+	_, err = From([]int{3, 3}).ZScores().ResultAndError()
+	assert.EqualError(t, err, "cannot compute z-scores of data with zero standard deviation")
+}
+
+func TestHistogram(t *testing.T) {
+	result, err := From([]int{0, 1, 2, 5, 9, 10}).Histogram(2).ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []stats.HistogramBin{
+		{Lower: 0, Upper: 5, Count: 3},
+		{Lower: 5, Upper: 10, Count: 3},
+	}, result)
+}
+
+func TestHistogramEdges(t *testing.T) {
+	type Request struct {
+		Path      string
+		LatencyMs int
+	}
+
+	data := []Request{{"/a", 120}, {"/b", 80}, {"/a", 200}, {"/c", 40}}
+
+	result, err := From(data).
+		HistogramEdges([]float64{50, 100, 150}, func(each Request) int {
+			return each.LatencyMs
+		}).
+		ResultAndError()
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []stats.HistogramBin{
+		{Lower: 50, Upper: 100, Count: 1},
+		{Lower: 100, Upper: 150, Count: 1},
+	}, result)
+}
+
+func TestHistogramEdgesInvalid(t *testing.T) {
+	_, err := From([]int{1}).HistogramEdges([]float64{1, 1}).ResultAndError()
+	assert.EqualError(t, err, "edges should be in strictly increasing order")
+}
+
+func TestStatsInvalidData(t *testing.T) {
+	_, err := From([]string{"a"}).Variance().ResultAndError()
+	assert.EqualError(t, err, "element data type should be number, or iteratee should be provided")
+
+	_, err = From(nil).Median().ResultAndError()
+	assert.NotNil(t, err)
+}
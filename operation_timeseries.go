@@ -0,0 +1,195 @@
+package gubrak
+
+import (
+	"errors"
+	"reflect"
+	"time"
+)
+
+// _seriesData inspects `data` and copies it into new slice, so each window can be sliced out of it
+func _seriesData(err *error, data interface{}) (reflect.Value, int) {
+	if !isNonNilData(err, "data", data) {
+		return reflect.Value{}, 0
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return reflect.Value{}, 0
+	}
+
+	series := reflect.MakeSlice(reflect.SliceOf(dataValue.Type().Elem()), dataValueLen, dataValueLen)
+	reflect.Copy(series, dataValue)
+
+	return series, dataValueLen
+}
+
+// _rolling calls `reducer` with trailing window of each element. The window of element `i` starts at `start(i)`.
+func _rolling(err *error, series reflect.Value, seriesLen int, reducer interface{}, start func(int) int) interface{} {
+	reducerValue := inspectFuncWithInputs(err, "reducer", reducer, series.Type())
+	if *err != nil {
+		return nil
+	}
+
+	result := makeSlice(reflect.SliceOf(reducerValue.Type().Out(0)), seriesLen, seriesLen)
+	for i := 0; i < seriesLen; i++ {
+		window := series.Slice3(start(i), i+1, i+1)
+		result.Index(i).Set(reducerValue.Call([]reflect.Value{window})[0])
+	}
+
+	return result.Interface()
+}
+
+func _rollingBy(err *error, data interface{}, duration time.Duration, timeFn, reducer interface{}) interface{} {
+	series, seriesLen := _seriesData(err, data)
+	if *err != nil {
+		return nil
+	}
+
+	if duration <= 0 {
+		*err = errors.New("duration should be greater than zero")
+		return nil
+	}
+
+	times := _timeKeys(err, series, seriesLen, timeFn)
+	if *err != nil {
+		return nil
+	}
+
+	for i := 1; i < seriesLen; i++ {
+		if times[i].Before(times[i-1]) {
+			*err = errors.New("data should be sorted by time in ascending order")
+			return nil
+		}
+	}
+
+	lower := 0
+	return _rolling(err, series, seriesLen, reducer, func(i int) int {
+		for times[i].Sub(times[lower]) >= duration {
+			lower++
+		}
+
+		return lower
+	})
+}
+
+func _resample(err *error, data interface{}, interval time.Duration, timeFn, aggregator interface{}) interface{} {
+	buckets, dataValue := _binByTime(err, data, interval, timeFn)
+	if *err != nil {
+		return nil
+	}
+
+	sliceType := reflect.SliceOf(dataValue.Type().Elem())
+	aggregatorValue := inspectFuncWithInputs(err, "aggregator", aggregator, timeType, sliceType)
+	if *err != nil {
+		return nil
+	}
+
+	result := makeSlice(reflect.SliceOf(aggregatorValue.Type().Out(0)))
+	if len(buckets) == 0 {
+		return result.Interface()
+	}
+
+	start, end := buckets[0].Start, buckets[0].End
+	for i := 0; i < len(buckets); {
+		items := makeSlice(sliceType)
+		if !buckets[i].Start.After(start) {
+			start, end = buckets[i].Start, buckets[i].End
+			items = reflect.ValueOf(buckets[i].Items)
+			i++
+		}
+
+		result = reflect.Append(result, aggregatorValue.Call([]reflect.Value{reflect.ValueOf(start), items})[0])
+		start, end = truncateTime(end, interval)
+	}
+
+	return result.Interface()
+}
+
+// _fill replaces missing elements using the nearest non-missing element before it (forward), or after it (backward)
+func _fill(err *error, data interface{}, isMissing interface{}, args []interface{}, isForward bool) interface{} {
+	series, seriesLen := _seriesData(err, data)
+	if *err != nil {
+		return nil
+	}
+
+	elemType := series.Type().Elem()
+
+	isMissingValue := inspectFuncWithInputs(err, "isMissing", isMissing, elemType)
+	if *err != nil {
+		return nil
+	}
+
+	if isMissingValue.Type().Out(0).Kind() != reflect.Bool {
+		*err = errors.New("isMissing return value data type should be bool")
+		return nil
+	}
+
+	fill := func(missing, source reflect.Value) reflect.Value {
+		return source
+	}
+
+	if len(args) > 0 && args[0] != nil {
+		fillerValue := inspectFuncWithInputs(err, "filler", args[0], elemType, elemType)
+		if *err != nil {
+			return nil
+		}
+
+		if !fillerValue.Type().Out(0).AssignableTo(elemType) {
+			*err = errors.New("filler return value data type should be same with data element type")
+			return nil
+		}
+
+		fill = func(missing, source reflect.Value) reflect.Value {
+			return fillerValue.Call([]reflect.Value{missing, source})[0]
+		}
+	}
+
+	first, last, step := 0, seriesLen, 1
+	if !isForward {
+		first, last, step = seriesLen-1, -1, -1
+	}
+
+	source := reflect.Value{}
+	for i := first; i != last; i += step {
+		each := series.Index(i)
+		if !isMissingValue.Call([]reflect.Value{each})[0].Bool() {
+			source = each
+			continue
+		}
+
+		if source.IsValid() {
+			each.Set(fill(each, source))
+			source = each
+		}
+	}
+
+	return series.Interface()
+}
+
+func _cumulativeSum(err *error, data interface{}, args []interface{}) []float64 {
+	if !isNonNilData(err, "data", data) {
+		return nil
+	}
+
+	dataValue, _, _, dataValueLen := inspectData(data)
+
+	if !isSlice(err, "data", dataValue) {
+		return nil
+	}
+
+	numberOf := elementIteratee(err, "element", dataValue.Type().Elem(), args, true)
+	if *err != nil {
+		return nil
+	}
+
+	accumulator := &numberAccumulator{}
+	result := make([]float64, 0, dataValueLen)
+	forEachSliceStoppable(dataValue, dataValueLen, func(each reflect.Value, i int) bool {
+		accumulator.add(err, numberOf(each))
+		result = append(result, accumulator.float())
+		return *err == nil
+	})
+
+	return result
+}
@@ -0,0 +1,312 @@
+// Package stats provides descriptive statistics of float numbers: median, percentiles, variance, covariance, z-scores and histograms.
+//
+// Use the chain operations of gubrak, e.g. `gubrak.From(data).Median(iteratee)`, to compute them on any numeric slice or on the result of a key extractor.
+package stats
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// PercentileMethod is the interpolation method used by `Percentile()` and `Quantile()` when the desired position lies between two data points
+type PercentileMethod int
+
+const (
+	// PercentileLinear interpolates linearly between the two nearest data points. It is the default method.
+	PercentileLinear PercentileMethod = iota
+
+	// PercentileLower takes the lower of the two nearest data points
+	PercentileLower
+
+	// PercentileHigher takes the higher of the two nearest data points
+	PercentileHigher
+
+	// PercentileNearest takes the nearest data point, ties are rounded to the even position
+	PercentileNearest
+
+	// PercentileMidpoint takes the average of the two nearest data points
+	PercentileMidpoint
+)
+
+// HistogramBin is a bin of histogram. Each bin covers `[Lower, Upper)`, except the last bin which also includes `Upper`.
+type HistogramBin struct {
+	Lower float64
+	Upper float64
+	Count int
+}
+
+// Median function computes the median of `numbers`. If `numbers` has even length, the median is the average of the two middle values.
+func Median(numbers []float64) (float64, error) {
+	return Quantile(numbers, 0.5, PercentileLinear)
+}
+
+// Quantile function computes the `q`-th quantile (between 0 and 1) of `numbers`
+func Quantile(numbers []float64, q float64, method PercentileMethod) (float64, error) {
+	if err := validate(numbers); err != nil {
+		return 0, err
+	}
+
+	if len(numbers) == 0 {
+		return 0, errors.New("data cannot be empty")
+	}
+
+	if math.IsNaN(q) || q < 0 || q > 1 {
+		return 0, errors.New("quantile should be between 0 and 1")
+	}
+
+	sorted := make([]float64, len(numbers))
+	copy(sorted, numbers)
+	sort.Float64s(sorted)
+
+	position := q * float64(len(sorted)-1)
+	lower, upper := int(math.Floor(position)), int(math.Ceil(position))
+
+	switch method {
+	case PercentileLinear:
+		if lower == upper {
+			return sorted[lower], nil
+		}
+
+		return sorted[lower] + (position-float64(lower))*(sorted[upper]-sorted[lower]), nil
+	case PercentileLower:
+		return sorted[lower], nil
+	case PercentileHigher:
+		return sorted[upper], nil
+	case PercentileNearest:
+		return sorted[int(math.RoundToEven(position))], nil
+	case PercentileMidpoint:
+		return (sorted[lower] + sorted[upper]) / 2, nil
+	}
+
+	return 0, errors.New("unknown percentile method")
+}
+
+// Percentile function computes the `p`-th percentile (between 0 and 100) of `numbers`
+func Percentile(numbers []float64, p float64, method PercentileMethod) (float64, error) {
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		return 0, errors.New("percentile should be between 0 and 100")
+	}
+
+	return Quantile(numbers, p/100, method)
+}
+
+// Variance function computes the population variance of `numbers`
+func Variance(numbers []float64) (float64, error) {
+	return covariance(numbers, numbers, 0)
+}
+
+// SampleVariance function computes the sample variance (with Bessel's correction) of `numbers`
+func SampleVariance(numbers []float64) (float64, error) {
+	return covariance(numbers, numbers, 1)
+}
+
+// StdDev function computes the population standard deviation of `numbers`
+func StdDev(numbers []float64) (float64, error) {
+	variance, err := covariance(numbers, numbers, 0)
+	return math.Sqrt(variance), err
+}
+
+// SampleStdDev function computes the sample standard deviation (with Bessel's correction) of `numbers`
+func SampleStdDev(numbers []float64) (float64, error) {
+	variance, err := covariance(numbers, numbers, 1)
+	return math.Sqrt(variance), err
+}
+
+// Covariance function computes the population covariance of `xs` and `ys`, both should have the same length
+func Covariance(xs, ys []float64) (float64, error) {
+	return covariance(xs, ys, 0)
+}
+
+// SampleCovariance function computes the sample covariance (with Bessel's correction) of `xs` and `ys`, both should have the same length
+func SampleCovariance(xs, ys []float64) (float64, error) {
+	return covariance(xs, ys, 1)
+}
+
+// Correlation function computes the Pearson correlation coefficient of `xs` and `ys`, both should have the same length
+func Correlation(xs, ys []float64) (float64, error) {
+	covarianceXY, err := covariance(xs, ys, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	varianceX, _ := covariance(xs, xs, 0)
+	varianceY, _ := covariance(ys, ys, 0)
+	if varianceX == 0 || varianceY == 0 {
+		return 0, errors.New("cannot compute correlation of data with zero variance")
+	}
+
+	correlation := covarianceXY / math.Sqrt(varianceX*varianceY)
+
+	// rounding error might push the result slightly out of range
+	return math.Max(-1, math.Min(1, correlation)), nil
+}
+
+// ZScores function computes the standard score of each of `numbers`. The scores are computed using the population standard deviation.
+func ZScores(numbers []float64) ([]float64, error) {
+	if err := validate(numbers); err != nil {
+		return nil, err
+	}
+
+	scores := make([]float64, len(numbers))
+	if len(numbers) == 0 {
+		return scores, nil
+	}
+
+	variance, err := covariance(numbers, numbers, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	stdDev := math.Sqrt(variance)
+	if stdDev == 0 {
+		return nil, errors.New("cannot compute z-scores of data with zero standard deviation")
+	}
+
+	mean := mean(numbers)
+	for i, number := range numbers {
+		scores[i] = (number - mean) / stdDev
+	}
+
+	return scores, nil
+}
+
+// Histogram function counts `numbers` into `binCount` bins of the same width, between the minimum and the maximum value
+func Histogram(numbers []float64, binCount int) ([]HistogramBin, error) {
+	if err := validate(numbers); err != nil {
+		return nil, err
+	}
+
+	if binCount <= 0 {
+		return nil, errors.New("bin count should be greater than zero")
+	}
+
+	lower, upper := 0.0, 1.0
+	if len(numbers) > 0 {
+		lower, upper = numbers[0], numbers[0]
+		for _, number := range numbers {
+			lower, upper = math.Min(lower, number), math.Max(upper, number)
+		}
+
+		if math.IsInf(lower, 0) || math.IsInf(upper, 0) {
+			return nil, errors.New("cannot compute fixed-width bins of data with infinite value")
+		}
+
+		if lower == upper {
+			lower, upper = lower-0.5, upper+0.5
+		}
+	}
+
+	edges := make([]float64, binCount+1)
+	width := (upper - lower) / float64(binCount)
+	for i := range edges {
+		edges[i] = lower + float64(i)*width
+	}
+
+	edges[binCount] = upper
+
+	return HistogramEdges(numbers, edges)
+}
+
+// HistogramEdges function counts `numbers` into bins defined by `edges`.
+// Use `math.Inf(-1)` and `math.Inf(1)` as the first and last edge for open-ended bins. Values outside the edges are not counted.
+func HistogramEdges(numbers []float64, edges []float64) ([]HistogramBin, error) {
+	if err := validate(numbers); err != nil {
+		return nil, err
+	}
+
+	if len(edges) < 2 {
+		return nil, errors.New("edges should have at least 2 elements")
+	}
+
+	for i, edge := range edges {
+		if math.IsNaN(edge) || (i > 0 && !(edges[i-1] < edge)) {
+			return nil, errors.New("edges should be in strictly increasing order")
+		}
+	}
+
+	bins := make([]HistogramBin, len(edges)-1)
+	for i := range bins {
+		bins[i] = HistogramBin{Lower: edges[i], Upper: edges[i+1]}
+	}
+
+	last := len(edges) - 1
+	for _, number := range numbers {
+		if number < edges[0] || number > edges[last] {
+			continue
+		}
+
+		i := sort.SearchFloat64s(edges, number)
+		if i == last || edges[i] != number {
+			i--
+		}
+
+		bins[i].Count++
+	}
+
+	return bins, nil
+}
+
+// validate checks that `numbers` does not contain NaN
+func validate(numbers []float64) error {
+	for _, number := range numbers {
+		if math.IsNaN(number) {
+			return errors.New("data should not contain NaN")
+		}
+	}
+
+	return nil
+}
+
+// sum computes the sum of `numbers` using compensated summation
+func sum(numbers []float64) float64 {
+	total, compensation := 0.0, 0.0
+	for _, number := range numbers {
+		next := total + number
+		if math.Abs(total) >= math.Abs(number) {
+			compensation += (total - next) + number
+		} else {
+			compensation += (number - next) + total
+		}
+
+		total = next
+	}
+
+	return total + compensation
+}
+
+func mean(numbers []float64) float64 {
+	return sum(numbers) / float64(len(numbers))
+}
+
+// covariance computes covariance using two-pass algorithm, `ddof` is the delta degrees of freedom (0 for population, 1 for sample)
+func covariance(xs, ys []float64, ddof int) (float64, error) {
+	if len(xs) != len(ys) {
+		return 0, errors.New("length of both data should be same")
+	}
+
+	if err := validate(xs); err != nil {
+		return 0, err
+	}
+
+	if err := validate(ys); err != nil {
+		return 0, err
+	}
+
+	if len(xs) == 0 {
+		return 0, errors.New("data cannot be empty")
+	}
+
+	if len(xs) <= ddof {
+		return 0, errors.New("data should have at least 2 elements")
+	}
+
+	meanX, meanY := mean(xs), mean(ys)
+
+	products := make([]float64, len(xs))
+	for i := range xs {
+		products[i] = (xs[i] - meanX) * (ys[i] - meanY)
+	}
+
+	return sum(products) / float64(len(xs)-ddof), nil
+}
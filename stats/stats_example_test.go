@@ -0,0 +1,27 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExamplePercentile() {
+	latencies := []float64{12, 15, 11, 250, 14, 13, 16, 18, 12, 900}
+
+	p50, _ := Percentile(latencies, 50, PercentileLinear)
+	p90, _ := Percentile(latencies, 90, PercentileNearest)
+	fmt.Println(p50, p90)
+	// ===> 14.5 250
+}
+
+func ExampleHistogramEdges() {
+	latencies := []float64{12, 15, 11, 250, 14, 13, 16, 18, 12, 900}
+
+	bins, _ := HistogramEdges(latencies, []float64{0, 100, 500, math.Inf(1)})
+	for _, bin := range bins {
+		fmt.Println(bin.Lower, bin.Upper, bin.Count)
+	}
+	// ===> 0 100 8
+	// ===> 100 500 1
+	// ===> 500 +Inf 1
+}
@@ -0,0 +1,181 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedian(t *testing.T) {
+	result, err := Median([]float64{5, 1, 3})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, result)
+
+	result, err = Median([]float64{5, 1, 3, 2})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2.5, result)
+}
+
+func TestMedianEmptyData(t *testing.T) {
+	_, err := Median([]float64{})
+	assert.EqualError(t, err, "data cannot be empty")
+}
+
+func TestMedianNaN(t *testing.T) {
+	_, err := Median([]float64{1, math.NaN()})
+	assert.EqualError(t, err, "data should not contain NaN")
+}
+
+func TestMedianDoesNotModifyData(t *testing.T) {
+	data := []float64{3, 1, 2}
+
+	_, err := Median(data)
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{3, 1, 2}, data)
+}
+
+func TestPercentileMethods(t *testing.T) {
+	data := []float64{10, 20, 30, 40}
+
+	expected := map[PercentileMethod]float64{
+		PercentileLinear:   25,
+		PercentileLower:    20,
+		PercentileHigher:   30,
+		PercentileNearest:  30,
+		PercentileMidpoint: 25,
+	}
+
+	for method, value := range expected {
+		result, err := Percentile(data, 50, method)
+		assert.Nil(t, err)
+		assert.EqualValues(t, value, result, "method %d", method)
+	}
+}
+
+func TestPercentileBounds(t *testing.T) {
+	data := []float64{3, 1, 2}
+
+	result, err := Percentile(data, 0, PercentileLinear)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, result)
+
+	result, err = Percentile(data, 100, PercentileLinear)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, result)
+
+	_, err = Percentile(data, 101, PercentileLinear)
+	assert.EqualError(t, err, "percentile should be between 0 and 100")
+}
+
+func TestPercentileUnknownMethod(t *testing.T) {
+	_, err := Percentile([]float64{1, 2}, 50, PercentileMethod(99))
+	assert.EqualError(t, err, "unknown percentile method")
+}
+
+func TestQuantile(t *testing.T) {
+	result, err := Quantile([]float64{1, 2, 3, 4, 5}, 0.9, PercentileLinear)
+	assert.Nil(t, err)
+	assert.InDelta(t, 4.6, result, 1e-9)
+
+	_, err = Quantile([]float64{1}, -0.1, PercentileLinear)
+	assert.EqualError(t, err, "quantile should be between 0 and 1")
+}
+
+func TestVarianceStdDev(t *testing.T) {
+	data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	result, err := Variance(data)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 4, result)
+
+	result, err = StdDev(data)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2, result)
+
+	result, err = SampleVariance(data)
+	assert.Nil(t, err)
+	assert.InDelta(t, 32.0/7, result, 1e-12)
+
+	result, err = SampleStdDev(data)
+	assert.Nil(t, err)
+	assert.InDelta(t, math.Sqrt(32.0/7), result, 1e-12)
+}
+
+func TestVarianceLargeOffset(t *testing.T) {
+	result, err := Variance([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 22.5, result)
+}
+
+func TestSampleVarianceSingleElement(t *testing.T) {
+	_, err := SampleVariance([]float64{1})
+	assert.EqualError(t, err, "data should have at least 2 elements")
+}
+
+func TestCorrelation(t *testing.T) {
+	result, err := Correlation([]float64{1, 2, 3}, []float64{3, 2, 1})
+	assert.Nil(t, err)
+	assert.EqualValues(t, -1, result)
+
+	_, err = Correlation([]float64{1, 1, 1}, []float64{1, 2, 3})
+	assert.EqualError(t, err, "cannot compute correlation of data with zero variance")
+
+	_, err = Covariance([]float64{1, 2}, []float64{1})
+	assert.EqualError(t, err, "length of both data should be same")
+}
+
+func TestZScores(t *testing.T) {
+	result, err := ZScores([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}, result)
+
+	result, err = ZScores([]float64{})
+	assert.Nil(t, err)
+	assert.EqualValues(t, []float64{}, result)
+
+	_, err = ZScores([]float64{3, 3})
+	assert.EqualError(t, err, "cannot compute z-scores of data with zero standard deviation")
+}
+
+func TestHistogram(t *testing.T) {
+	result, err := Histogram([]float64{0, 1, 2, 5, 9, 10}, 2)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []HistogramBin{
+		{Lower: 0, Upper: 5, Count: 3},
+		{Lower: 5, Upper: 10, Count: 3},
+	}, result)
+}
+
+func TestHistogramSingleValue(t *testing.T) {
+	result, err := Histogram([]float64{4, 4}, 1)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []HistogramBin{{Lower: 3.5, Upper: 4.5, Count: 2}}, result)
+}
+
+func TestHistogramInvalidBinCount(t *testing.T) {
+	_, err := Histogram([]float64{1}, 0)
+	assert.EqualError(t, err, "bin count should be greater than zero")
+}
+
+func TestHistogramEdgesOpenEnded(t *testing.T) {
+	result, err := HistogramEdges([]float64{-3, 0, 0.5, 1, 7}, []float64{math.Inf(-1), 0, 1, math.Inf(1)})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, []HistogramBin{
+		{Lower: math.Inf(-1), Upper: 0, Count: 1},
+		{Lower: 0, Upper: 1, Count: 2},
+		{Lower: 1, Upper: math.Inf(1), Count: 2},
+	}, result)
+}
+
+func TestHistogramEdgesInvalid(t *testing.T) {
+	_, err := HistogramEdges([]float64{1}, []float64{1})
+	assert.EqualError(t, err, "edges should have at least 2 elements")
+
+	_, err = HistogramEdges([]float64{1}, []float64{1, 1})
+	assert.EqualError(t, err, "edges should be in strictly increasing order")
+}